@@ -0,0 +1,158 @@
+package color
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+// ANSIReset is the SGR escape sequence that resets all colors and styles of a terminal
+const ANSIReset = "\x1b[0m"
+
+// ansi16Colors holds the default xterm values of the 16 standard and bright terminal colors
+var ansi16Colors = [16]RGBAColor{
+	{0, 0, 0, 1.0}, {205, 0, 0, 1.0}, {0, 205, 0, 1.0}, {205, 205, 0, 1.0},
+	{0, 0, 238, 1.0}, {205, 0, 205, 1.0}, {0, 205, 205, 1.0}, {229, 229, 229, 1.0},
+	{127, 127, 127, 1.0}, {255, 0, 0, 1.0}, {0, 255, 0, 1.0}, {255, 255, 0, 1.0},
+	{92, 92, 255, 1.0}, {255, 0, 255, 1.0}, {0, 255, 255, 1.0}, {255, 255, 255, 1.0},
+}
+
+// ansiCubeLevels holds the channel values of the 6x6x6 color cube of the 256-color mode (indices 16-231)
+var ansiCubeLevels = [6]int{0, 95, 135, 175, 215, 255}
+
+// ANSI generates a random ANSI SGR escape sequence that sets the foreground color of a terminal
+// WithANSIMode selects the 16-color, 256-color or truecolor (default) mode, and WithANSIBackground sets
+// the background color instead; print ANSIReset to restore the default colors
+// With range options the color is drawn like RGB and converted to the nearest color of the mode
+func ANSI(opts ...OptionFunc) string {
+	return ansiSequence(randomANSIParameters(opts))
+}
+
+// ANSI returns the ANSI SGR escape sequence that sets the foreground color of a terminal to the color
+// It uses the same options as the ANSI function, and in the 16-color and 256-color modes the color is
+// replaced with the nearest color of the mode (see ANSI16 and ANSI256); the alpha channel is ignored
+func (r RGBAColor) ANSI(opts ...OptionFunc) string {
+	o := applyOptions(opts)
+	return ansiSequence(r.ansiParameters(o))
+}
+
+// ANSI16 returns the index (0-15) of the nearest of the 16 standard and bright terminal colors
+// Terminals often customize these colors, so the default xterm values are used
+func (r RGBAColor) ANSI16() int {
+	return nearestANSIColor(r, ansi16Colors[:])
+}
+
+// ANSI256 returns the index of the nearest color of the 256-color mode, in the color cube (16-231)
+// or the gray ramp (232-255); the 16 standard colors are never returned since terminals often customize them
+func (r RGBAColor) ANSI256() int {
+	// The nearest cube color has the nearest level in each channel
+	cubeIndex := func(channel int) int {
+		nearest := 0
+		for i, level := range ansiCubeLevels {
+			if max(channel-level, level-channel) < max(channel-ansiCubeLevels[nearest], ansiCubeLevels[nearest]-channel) {
+				nearest = i
+			}
+		}
+		return nearest
+	}
+	red, green, blue := cubeIndex(clampChannel(r.Red)), cubeIndex(clampChannel(r.Green)), cubeIndex(clampChannel(r.Blue))
+	cube := RGBAColor{Red: ansiCubeLevels[red], Green: ansiCubeLevels[green], Blue: ansiCubeLevels[blue], Alpha: 1.0}
+
+	// The nearest gray has the nearest value to the average channel, in steps of 10 from 8 to 238
+	average := (clampChannel(r.Red) + clampChannel(r.Green) + clampChannel(r.Blue)) / 3
+	grayStep := clampInt(int(math.Round(float64(average-8)/10)), 0, 23)
+	gray := RGBAColor{Red: 8 + 10*grayStep, Green: 8 + 10*grayStep, Blue: 8 + 10*grayStep, Alpha: 1.0}
+
+	if nearestANSIColor(r, []RGBAColor{cube, gray}) == 0 {
+		return 16 + 36*red + 6*green + blue
+	}
+	return 232 + grayStep
+}
+
+// Styled wraps a string in a random ANSI style: a random color (with the same options as ANSI),
+// randomly bold and randomly underlined, followed by ANSIReset
+func Styled(s string, opts ...OptionFunc) string {
+	var parameters []string
+	if random.IntN(2) == 0 {
+		parameters = append(parameters, "1")
+	}
+	if random.IntN(2) == 0 {
+		parameters = append(parameters, "4")
+	}
+
+	parameters = append(parameters, randomANSIParameters(opts))
+
+	return ansiSequence(strings.Join(parameters, ";")) + s + ANSIReset
+}
+
+// randomANSIParameters returns the SGR parameters that set a random color in the mode of the options
+func randomANSIParameters(opts []OptionFunc) string {
+	o := applyOptions(opts)
+
+	switch {
+	case o.hasColorRange() || o.ansiMode == ANSIModeTrueColor:
+		return RGB(opts...).ansiParameters(o)
+	case o.ansiMode == ANSIMode16:
+		return ansi16Parameters(random.IntN(16), o.ansiBackground)
+	default:
+		return ansi256Parameters(random.IntN(256), o.ansiBackground)
+	}
+}
+
+// ansiParameters returns the SGR parameters that set the color in the mode of the options
+func (r RGBAColor) ansiParameters(o Option) string {
+	switch o.ansiMode {
+	case ANSIMode16:
+		return ansi16Parameters(r.ANSI16(), o.ansiBackground)
+	case ANSIMode256:
+		return ansi256Parameters(r.ANSI256(), o.ansiBackground)
+	}
+
+	prefix := "38;2;"
+	if o.ansiBackground {
+		prefix = "48;2;"
+	}
+	return prefix + strconv.Itoa(clampChannel(r.Red)) + ";" + strconv.Itoa(clampChannel(r.Green)) + ";" + strconv.Itoa(clampChannel(r.Blue))
+}
+
+// ansi16Parameters returns the SGR parameter of one of the 16 terminal colors:
+// 30-37 and 90-97 for foregrounds, and 40-47 and 100-107 for backgrounds
+func ansi16Parameters(index int, background bool) string {
+	code := 30 + index
+	if index >= 8 {
+		code = 90 + index - 8
+	}
+	if background {
+		code += 10
+	}
+	return strconv.Itoa(code)
+}
+
+// ansi256Parameters returns the SGR parameters of a color of the 256-color mode
+func ansi256Parameters(index int, background bool) string {
+	if background {
+		return "48;5;" + strconv.Itoa(index)
+	}
+	return "38;5;" + strconv.Itoa(index)
+}
+
+// ansiSequence returns the SGR escape sequence with the given parameters
+func ansiSequence(parameters string) string {
+	return "\x1b[" + parameters + "m"
+}
+
+// nearestANSIColor returns the index of the color that is the nearest to c in the OKLab color space
+// Ties are resolved in favor of the first color
+func nearestANSIColor(c RGBAColor, colors []RGBAColor) int {
+	lab := toOKLab(c)
+
+	nearest, nearestDistance := 0, labDistance(lab, toOKLab(colors[0]))
+	for i := 1; i < len(colors); i++ {
+		if distance := labDistance(lab, toOKLab(colors[i])); distance < nearestDistance {
+			nearest, nearestDistance = i, distance
+		}
+	}
+	return nearest
+}
@@ -19,8 +19,15 @@ func (c CMYKColor) String() string {
 		c.Cyan, c.Magenta, c.Yellow, c.Key)
 }
 
-// CMYK generates a random CMYK color
-func CMYK() CMYKColor {
+// CMYK generates a random CMYK color based on the provided options
+// With hue, saturation, lightness or grayscale options the color is drawn in the HSL space and converted
+func CMYK(opts ...OptionFunc) CMYKColor {
+	o := applyOptions(opts)
+
+	if o.hasColorRange() {
+		return randomHSL(o).ToCMYK()
+	}
+
 	return CMYKColor{
 		Cyan:    random.IntN(101), // 0-100 percent
 		Magenta: random.IntN(101), // 0-100 percent
@@ -1,5 +1,6 @@
 // Package color provides functionality for generating random colors in different color spaces.
-// It supports RGB, HSL, CMYK color spaces and named colors with localization options.
+// It supports the RGB, HSL, CMYK, HSV, HWB, CIE XYZ, CIELAB, LCH, OKLab and OKLCH color spaces
+// and the CSS named colors with localized names.
 package color
 
 var (
@@ -7,5 +8,8 @@ var (
 		"en": {
 			"name": "white",
 		},
+		"de": {
+			"name": "Weiß",
+		},
 	}
 )
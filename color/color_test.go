@@ -1,12 +1,28 @@
 package color
 
 import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	imagecolor "image/color"
+	"math"
 	"math/rand/v2"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/khchehab/muzayaf/random"
 )
 
+// absDiff returns the absolute difference between an 8-bit channel and an expected value
+func absDiff(channel uint8, expected int) int {
+	return max(int(channel)-expected, expected-int(channel))
+}
+
 // setupTest sets up a deterministic random source for testing
 func setupTest(t *testing.T) {
 	t.Helper()
@@ -201,7 +217,7 @@ func TestColorName(t *testing.T) {
 
 	// With a fixed seed, we should get consistent results
 	expectedName1 := "paleturquoise"
-	expectedName2 := "darkseagreen"
+	expectedName2 := "darkslateblue"
 
 	if name1 != expectedName1 {
 		t.Errorf("ColorName() = %v, want %v", name1, expectedName1)
@@ -227,7 +243,7 @@ func TestColorNameWithLocale(t *testing.T) {
 
 	// Test with non-existent locale (should fall back to "en")
 	name = ColorName(WithLocale("non-existent"))
-	expectedName = "darkseagreen" // Same as "en" with our fixed seed
+	expectedName = "darkslateblue" // Same as "en" with our fixed seed
 
 	if name != expectedName {
 		t.Errorf("ColorName(WithLocale(\"non-existent\")) = %v, want %v", name, expectedName)
@@ -277,6 +293,1332 @@ func TestCMYKColorString(t *testing.T) {
 	}
 }
 
+// TestConversions tests the conversion methods between RGBA, HSLA and CMYK colors
+func TestConversions(t *testing.T) {
+	tests := []struct {
+		name string
+		rgba RGBAColor
+		hsla HSLAColor
+		cmyk CMYKColor
+	}{
+		{"red", RGBAColor{255, 0, 0, 1.0}, HSLAColor{0, 100, 50, 1.0}, CMYKColor{0, 100, 100, 0}},
+		{"teal", RGBAColor{0, 128, 128, 1.0}, HSLAColor{180, 100, 25, 1.0}, CMYKColor{100, 0, 0, 50}},
+		{"white", RGBAColor{255, 255, 255, 1.0}, HSLAColor{0, 0, 100, 1.0}, CMYKColor{0, 0, 0, 0}},
+		{"black", RGBAColor{0, 0, 0, 1.0}, HSLAColor{0, 0, 0, 1.0}, CMYKColor{0, 0, 0, 100}},
+		{"gray", RGBAColor{128, 128, 128, 1.0}, HSLAColor{0, 0, 50, 1.0}, CMYKColor{0, 0, 0, 50}},
+		{"steel blue", RGBAColor{51, 102, 153, 0.5}, HSLAColor{210, 50, 40, 0.5}, CMYKColor{67, 33, 0, 40}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.rgba.ToHSLA(); got != tt.hsla {
+			t.Errorf("%s: %v.ToHSLA() = %v, want %v", tt.name, tt.rgba, got, tt.hsla)
+		}
+		if got := tt.rgba.ToCMYK(); got != tt.cmyk {
+			t.Errorf("%s: %v.ToCMYK() = %v, want %v", tt.name, tt.rgba, got, tt.cmyk)
+		}
+		if got := tt.hsla.ToRGBA(); got != tt.rgba {
+			t.Errorf("%s: %v.ToRGBA() = %v, want %v", tt.name, tt.hsla, got, tt.rgba)
+		}
+		if got := tt.hsla.ToCMYK(); got != tt.cmyk {
+			t.Errorf("%s: %v.ToCMYK() = %v, want %v", tt.name, tt.hsla, got, tt.cmyk)
+		}
+		if got := tt.cmyk.ToHSLA(); got.Hue != tt.hsla.Hue || got.Saturation != tt.hsla.Saturation || got.Lightness != tt.hsla.Lightness {
+			t.Errorf("%s: %v.ToHSLA() = %v, want %v", tt.name, tt.cmyk, got, tt.hsla)
+		}
+	}
+
+	// Test that CMYK conversions give full alpha
+	if got := (CMYKColor{0, 100, 100, 0}).ToRGBA(); got != (RGBAColor{255, 0, 0, 1.0}) {
+		t.Errorf("CMYKColor{0, 100, 100, 0}.ToRGBA() = %v, want %v", got, RGBAColor{255, 0, 0, 1.0})
+	}
+
+	// Test the documented round-trip precision over a sample of the RGB cube
+	abs := func(n int) int {
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+	for red := 0; red < 256; red += 3 {
+		for green := 0; green < 256; green += 5 {
+			for blue := 0; blue < 256; blue += 7 {
+				rgba := RGBAColor{red, green, blue, 1.0}
+				viaHSLA := rgba.ToHSLA().ToRGBA()
+				viaCMYK := rgba.ToCMYK().ToRGBA()
+
+				if max(abs(viaHSLA.Red-red), abs(viaHSLA.Green-green), abs(viaHSLA.Blue-blue)) > 5 {
+					t.Errorf("%v.ToHSLA().ToRGBA() = %v, want each channel within 5", rgba, viaHSLA)
+				}
+				if max(abs(viaCMYK.Red-red), abs(viaCMYK.Green-green), abs(viaCMYK.Blue-blue)) > 2 {
+					t.Errorf("%v.ToCMYK().ToRGBA() = %v, want each channel within 2", rgba, viaCMYK)
+				}
+			}
+		}
+	}
+}
+
+// TestHex tests the Hex function
+func TestHex(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test multiple calls to ensure reproducibility
+	hex1 := Hex()
+	hex2 := Hex()
+
+	// With a fixed seed, we should get consistent results
+	expectedHex1 := "#9dbffb"
+	expectedHex2 := "#66a481"
+
+	if hex1 != expectedHex1 {
+		t.Errorf("Hex() = %v, want %v", hex1, expectedHex1)
+	}
+
+	if hex2 != expectedHex2 {
+		t.Errorf("Hex() second call = %v, want %v", hex2, expectedHex2)
+	}
+
+	// Test the length and format of every option combination
+	for _, digits := range []int{3, 4, 6, 8} {
+		hex := Hex(WithHexDigits(digits), WithHexUppercase(true), WithHexPrefix(false))
+		if len(hex) != digits || strings.ToUpper(hex) != hex || strings.HasPrefix(hex, "#") {
+			t.Errorf("Hex(WithHexDigits(%d), WithHexUppercase(true), WithHexPrefix(false)) = %v", digits, hex)
+		}
+	}
+}
+
+// TestRGBAColorHex tests the Hex method of RGBAColor
+func TestRGBAColorHex(t *testing.T) {
+	rgba := RGBAColor{Red: 255, Green: 136, Blue: 0, Alpha: 0.5}
+
+	tests := []struct {
+		opts     []OptionFunc
+		expected string
+	}{
+		{nil, "#ff8800"},
+		{[]OptionFunc{WithHexDigits(3)}, "#f80"},
+		{[]OptionFunc{WithHexDigits(4)}, "#f808"},
+		{[]OptionFunc{WithHexDigits(8)}, "#ff880080"},
+		{[]OptionFunc{WithHexUppercase(true)}, "#FF8800"},
+		{[]OptionFunc{WithHexPrefix(false)}, "ff8800"},
+	}
+
+	for _, tt := range tests {
+		if got := rgba.Hex(tt.opts...); got != tt.expected {
+			t.Errorf("%v.Hex() = %v, want %v", rgba, got, tt.expected)
+		}
+	}
+}
+
+// TestParse tests the Parse function
+func TestParse(t *testing.T) {
+	validTests := []struct {
+		input    string
+		expected Color
+	}{
+		{"teal", RGBAColor{0, 128, 128, 1.0}},
+		{" RebeccaPurple ", RGBAColor{102, 51, 153, 1.0}},
+		{"#ff8800", RGBAColor{255, 136, 0, 1.0}},
+		{"#FF8800", RGBAColor{255, 136, 0, 1.0}},
+		{"ff8800", RGBAColor{255, 136, 0, 1.0}},
+		{"#f80", RGBAColor{255, 136, 0, 1.0}},
+		{"#f800", RGBAColor{255, 136, 0, 0.0}},
+		{"#ff8800ff", RGBAColor{255, 136, 0, 1.0}},
+		{"rgb(157, 191, 251)", RGBAColor{157, 191, 251, 1.0}},
+		{"rgba(157, 191, 251, 0.87)", RGBAColor{157, 191, 251, 0.87}},
+		{"hsl(270°, 24%, 74%)", HSLAColor{270, 24, 74, 1.0}},
+		{"hsla(270°, 24%, 74%, 0.87)", HSLAColor{270, 24, 74, 0.87}},
+		{"cmyk(75%, 24%, 74%, 87%)", CMYKColor{75, 24, 74, 87}},
+		{"rgb(0 128 128 / 50%)", RGBAColor{0, 128, 128, 0.5}},
+		{"hsva(207°, 61%, 71%, 0.5)", HSVAColor{207, 61, 71, 0.5}},
+		{"hwb(207 27% 29% / 0.5)", HWBAColor{207, 27, 29, 0.5}},
+		{"color(xyz-d65 0.1875 0.2056 0.4616)", XYZColor{0.1875, 0.2056, 0.4616, 1.0}},
+		{"lab(51.99% -8.36 -32.83)", LabColor{51.99, -8.36, -32.83, 1.0}},
+		{"lch(51.99 33.88 255.71deg / 0.25)", LCHColor{51.99, 33.88, 255.71, 0.25}},
+		{"oklab(58.8% -0.0408 -0.0906)", OKLabColor{0.588, -0.0408, -0.0906, 1.0}},
+		{"oklch(0.588 0.0993 245.74)", OKLCHColor{0.588, 0.0993, 245.74, 1.0}},
+	}
+
+	for _, tt := range validTests {
+		got, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q) returned error: %v", tt.input, err)
+		} else if got != tt.expected {
+			t.Errorf("Parse(%q) = %#v, want %#v", tt.input, got, tt.expected)
+		}
+	}
+
+	// Test that the String methods round-trip through Parse
+	setupTest(t)
+	defer teardownTest(t)
+
+	for i := 0; i < 100; i++ {
+		for _, c := range []Color{RGBA(), RGB(), HSLA(), HSL(), CMYK()} {
+			if got, err := Parse(c.String()); err != nil || got != c {
+				t.Errorf("Parse(%q) = %v, %v, want %v", c.String(), got, err, c)
+			}
+		}
+
+		rgb := RGB()
+		if got, err := Parse(rgb.Hex()); err != nil || got != rgb {
+			t.Errorf("Parse(%q) = %v, %v, want %v", rgb.Hex(), got, err, rgb)
+		}
+
+		// The String methods of the CIE color types round their channels
+		alpha := WithAlphaRange(0, 1)
+		for _, c := range []Color{HSV(alpha), HWB(alpha), XYZ(alpha), Lab(alpha), LCH(alpha), OKLab(alpha), OKLCH(alpha)} {
+			if got, err := Parse(c.String()); err != nil || got.String() != c.String() {
+				t.Errorf("Parse(%q) = %v, %v, want %v", c.String(), got, err, c)
+			}
+		}
+	}
+
+	// Test that invalid colors are rejected
+	invalidTests := []string{"", "#ff888", "#gg8800", "rgb(256, 0, 0)", "rgb(0, 0)", "rgba(0, 0, 0, 2)", "rgba(0, 0, 0, NaN)",
+		"hsl(361°, 0%, 0%)", "hsl(0°, 101%, 0%)", "cmyk(0%, 0%, 0%)", "lab(50 0)", "oklch(0.5 0.1 NaN)", "color(srgb 0 0 0)", "hwb(0 0% 0% / 2)", "foo(0, 0, 0)",
+		"rgb(0, 0, 0"}
+
+	for _, input := range invalidTests {
+		if got, err := Parse(input); !errors.Is(err, ErrInvalidColor) {
+			t.Errorf("Parse(%q) = %v, %v, want ErrInvalidColor", input, got, err)
+		}
+	}
+}
+
+// TestColorRanges tests the hue, saturation, lightness and alpha range options
+func TestColorRanges(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	hsla := HSLA(WithHueRange(200, 220), WithSaturationRange(50, 60), WithLightnessRange(40, 50), WithAlphaRange(0.5, 0.6))
+	expectedHSLA := HSLAColor{Hue: 215, Saturation: 52, Lightness: 48, Alpha: 0.59}
+	if hsla != expectedHSLA {
+		t.Errorf("HSLA() with ranges = %+v, want %+v", hsla, expectedHSLA)
+	}
+
+	opts := []OptionFunc{WithHueRange(330, 30), WithSaturationRange(90, 60), WithLightnessRange(20, 80), WithAlphaRange(0.25, 0.5)}
+	for i := 0; i < 1000; i++ {
+		hsla := HSLA(opts...)
+		if hsla.Hue > 30 && hsla.Hue < 330 {
+			t.Errorf("HSLA() hue = %d, want a hue in the wrapped range 330-30", hsla.Hue)
+		}
+		if hsla.Saturation < 60 || hsla.Saturation > 90 || hsla.Lightness < 20 || hsla.Lightness > 80 {
+			t.Errorf("HSLA() = %v, want saturation 60-90%% and lightness 20-80%%", hsla)
+		}
+		if hsla.Alpha < 0.25 || hsla.Alpha > 0.5 {
+			t.Errorf("HSLA() alpha = %v, want an alpha in 0.25-0.5", hsla.Alpha)
+		}
+
+		if hsl := HSL(opts...); hsl.Alpha != 1.0 {
+			t.Errorf("HSL() alpha = %v, want 1.0", hsl.Alpha)
+		}
+
+		// RGBA and CMYK colors are drawn in the HSL space, so they convert back within the ranges
+		// up to the rounding of the conversions
+		rgba := RGBA(opts...)
+		if converted := rgba.ToHSLA(); converted.Lightness < 19 || converted.Lightness > 81 {
+			t.Errorf("RGBA() = %v (%v), want lightness 20-80%%", rgba, converted)
+		}
+		if rgba.Alpha < 0.25 || rgba.Alpha > 0.5 {
+			t.Errorf("RGBA() alpha = %v, want an alpha in 0.25-0.5", rgba.Alpha)
+		}
+
+		cmyk := CMYK(opts...)
+		if converted := cmyk.ToHSLA(); converted.Lightness < 18 || converted.Lightness > 82 {
+			t.Errorf("CMYK() = %v (%v), want lightness 20-80%%", cmyk, converted)
+		}
+	}
+
+	// Test that alpha ranges without a multiple of 0.01 return the minimum
+	if rgba := RGBA(WithAlphaRange(0.501, 0.509)); rgba.Alpha != 0.501 {
+		t.Errorf("RGBA(WithAlphaRange(0.501, 0.509)) alpha = %v, want 0.501", rgba.Alpha)
+	}
+
+	// Test that each hue of a range covering the full circle is drawn once, with 360 drawn as 0
+	hues := make(map[int]int)
+	for _, o := range []Option{
+		applyOptions([]OptionFunc{WithHueRange(1, 360)}),
+		applyOptions([]OptionFunc{WithHueRange(360, 359)}),
+		applyOptions([]OptionFunc{WithHueRange(10, 9)}),
+	} {
+		var count int
+		sourceHue(o, func(n int) int { count = n; return 0 })
+		for n := 0; n < count; n++ {
+			hues[sourceHue(o, func(int) int { return n })]++
+		}
+		for hue := 0; hue < 360; hue++ {
+			if hues[hue] != 1 {
+				t.Errorf("sourceHue() with the hue range %d-%d draws hue %d %d times, want once", o.hueMin, o.hueMax, hue, hues[hue])
+			}
+		}
+		clear(hues)
+	}
+
+	// Test that the alpha range alone keeps the RGB channels unconstrained
+	if o := applyOptions([]OptionFunc{WithAlphaRange(0.2, 0.4)}); o.hasColorRange() {
+		t.Error("WithAlphaRange() should not constrain the RGB channels")
+	}
+}
+
+// TestGrayscale tests the WithGrayscale option
+func TestGrayscale(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	for i := 0; i < 1000; i++ {
+		if rgb := RGB(WithGrayscale(true), WithHueRange(100, 200)); rgb.Red != rgb.Green || rgb.Green != rgb.Blue {
+			t.Errorf("RGB(WithGrayscale(true)) = %v, want a gray", rgb)
+		}
+		if hsl := HSL(WithGrayscale(true)); hsl.Hue != 0 || hsl.Saturation != 0 {
+			t.Errorf("HSL(WithGrayscale(true)) = %v, want hue and saturation 0", hsl)
+		}
+		if cmyk := CMYK(WithGrayscale(true)); cmyk.Cyan != 0 || cmyk.Magenta != 0 || cmyk.Yellow != 0 {
+			t.Errorf("CMYK(WithGrayscale(true)) = %v, want only a key", cmyk)
+		}
+		if hex := Hex(WithGrayscale(true)); hex[1:3] != hex[3:5] || hex[3:5] != hex[5:7] {
+			t.Errorf("Hex(WithGrayscale(true)) = %v, want a gray", hex)
+		}
+	}
+}
+
+// TestPresets tests the WithPreset option
+func TestPresets(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	tests := []struct {
+		preset                                                   string
+		saturationMin, saturationMax, lightnessMin, lightnessMax int
+	}{
+		{PresetPastel, 40, 70, 80, 90},
+		{PresetVivid, 80, 100, 40, 60},
+		{PresetDark, 30, 100, 10, 30},
+		{PresetLight, 20, 100, 65, 85},
+		{"unknown", 0, 100, 0, 100},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			hsl := HSL(WithPreset(tt.preset))
+			if hsl.Saturation < tt.saturationMin || hsl.Saturation > tt.saturationMax ||
+				hsl.Lightness < tt.lightnessMin || hsl.Lightness > tt.lightnessMax {
+				t.Errorf("HSL(WithPreset(%q)) = %v, want saturation %d-%d%% and lightness %d-%d%%", tt.preset, hsl,
+					tt.saturationMin, tt.saturationMax, tt.lightnessMin, tt.lightnessMax)
+			}
+		}
+	}
+
+	// Test that later range options override the preset
+	for i := 0; i < 100; i++ {
+		if hsl := HSL(WithPreset(PresetVivid), WithLightnessRange(10, 20)); hsl.Lightness < 10 || hsl.Lightness > 20 {
+			t.Errorf("HSL(WithPreset(PresetVivid), WithLightnessRange(10, 20)) = %v, want lightness 10-20%%", hsl)
+		}
+	}
+}
+
+// TestPalette tests the Palette function
+func TestPalette(t *testing.T) {
+	base := HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 0.5}
+
+	tests := []struct {
+		scheme string
+		n      int
+		hues   []int
+	}{
+		{SchemeComplementary, 2, []int{210, 30}},
+		{SchemeSplitComplementary, 3, []int{210, 0, 60}},
+		{SchemeTriadic, 3, []int{210, 330, 90}},
+		{SchemeTetradic, 4, []int{210, 300, 30, 120}},
+		{SchemeAnalogous, 5, []int{210, 240, 180, 270, 150}},
+		{SchemeMonochromatic, 3, []int{210, 210, 210}},
+	}
+
+	for _, tt := range tests {
+		palette := Palette(tt.n, tt.scheme, WithPaletteBase(base))
+		if len(palette) != tt.n {
+			t.Fatalf("Palette(%d, %q) returned %d colors", tt.n, tt.scheme, len(palette))
+		}
+		if palette[0] != base {
+			t.Errorf("Palette(%d, %q)[0] = %v, want the base color %v", tt.n, tt.scheme, palette[0], base)
+		}
+		for i, c := range palette {
+			if c.Hue != tt.hues[i] || c.Saturation != base.Saturation || c.Alpha != base.Alpha {
+				t.Errorf("Palette(%d, %q)[%d] = %v, want hue %d with the base saturation and alpha", tt.n, tt.scheme, i, c, tt.hues[i])
+			}
+		}
+	}
+
+	// Test that repeated hues use different lightnesses
+	expectedLightnesses := []int{40, 50, 30, 60, 20, 70, 10, 80}
+	for i, c := range Palette(8, SchemeMonochromatic, WithPaletteBase(base)) {
+		if c.Lightness != expectedLightnesses[i] {
+			t.Errorf("Palette(8, SchemeMonochromatic)[%d] lightness = %d, want %d", i, c.Lightness, expectedLightnesses[i])
+		}
+	}
+	for _, scheme := range []string{SchemeComplementary, SchemeTriadic, SchemeMonochromatic} {
+		palette := Palette(30, scheme, WithPaletteBase(base))
+		seen := make(map[HSLAColor]bool)
+		for _, c := range palette {
+			if seen[c] {
+				t.Errorf("Palette(30, %q) contains %v twice", scheme, c)
+			}
+			seen[c] = true
+		}
+	}
+
+	// Test that a random base is reproducible
+	setupTest(t)
+	defer teardownTest(t)
+
+	palette := Palette(3, SchemeTriadic)
+	expectedPalette := []HSLAColor{
+		{Hue: 270, Saturation: 24, Lightness: 70, Alpha: 1.0},
+		{Hue: 30, Saturation: 24, Lightness: 70, Alpha: 1.0},
+		{Hue: 150, Saturation: 24, Lightness: 70, Alpha: 1.0},
+	}
+	if !slices.Equal(palette, expectedPalette) {
+		t.Errorf("Palette(3, SchemeTriadic) = %v, want %v", palette, expectedPalette)
+	}
+
+	// Test edge cases
+	if palette := Palette(0, SchemeTriadic); len(palette) != 0 {
+		t.Errorf("Palette(0, SchemeTriadic) = %v, want an empty palette", palette)
+	}
+	lightnesses := make(map[int]bool)
+	for _, c := range Palette(12, SchemeComplementary, WithPaletteBase(base), WithLightnessRange(40, 42)) {
+		lightnesses[c.Lightness] = true
+	}
+	if len(lightnesses) != 3 {
+		t.Errorf("Palette(12, SchemeComplementary) with lightness 40-42%% uses lightnesses %v, want 40, 41 and 42", lightnesses)
+	}
+}
+
+// TestDistinctPalette tests the Palette function with the distinct scheme
+func TestDistinctPalette(t *testing.T) {
+	base := HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 1.0}
+
+	palette := Palette(12, SchemeDistinct, WithPaletteBase(base))
+	if len(palette) != 12 || palette[0] != base {
+		t.Fatalf("Palette(12, SchemeDistinct) = %v, want 12 colors starting with %v", palette, base)
+	}
+
+	// Every pair of colors should be clearly distinguishable
+	for i := range palette {
+		for j := i + 1; j < len(palette); j++ {
+			if distance := labDistance(toOKLab(palette[i].ToRGBA()), toOKLab(palette[j].ToRGBA())); distance < 0.1 {
+				t.Errorf("Palette(12, SchemeDistinct) colors %v and %v are too close (%.3f)", palette[i], palette[j], distance)
+			}
+		}
+	}
+
+	// Test that the ranges constrain the picked colors
+	palette = Palette(6, SchemeDistinct, WithPaletteBase(base), WithHueRange(180, 270), WithLightnessRange(30, 60))
+	for _, c := range palette[1:] {
+		if c.Hue < 180 || c.Hue > 270 || c.Lightness < 30 || c.Lightness > 60 {
+			t.Errorf("Palette(6, SchemeDistinct) with ranges contains %v, want hue 180-270 and lightness 30-60%%", c)
+		}
+	}
+
+	// Test that palettes stop when the candidates run out, without repeating colors
+	gray := Palette(20, SchemeDistinct, WithPaletteBase(base), WithGrayscale(true))
+	if len(gray) != 6 {
+		t.Errorf("Palette(20, SchemeDistinct) in grayscale returned %d colors, want the base and 5 gray candidates", len(gray))
+	}
+	for i := range gray {
+		for j := i + 1; j < len(gray); j++ {
+			if gray[i] == gray[j] {
+				t.Errorf("Palette(20, SchemeDistinct) in grayscale contains %v twice", gray[i])
+			}
+		}
+	}
+
+	// Test that unknown schemes are rejected
+	if unknown := Palette(6, "unknown", WithPaletteBase(base)); len(unknown) != 0 {
+		t.Errorf("Palette(6, %q) = %v, want an empty palette", "unknown", unknown)
+	}
+}
+
+// TestContrast tests the Luminance and ContrastRatio methods
+func TestContrast(t *testing.T) {
+	black := RGBAColor{Red: 0, Green: 0, Blue: 0, Alpha: 1.0}
+	white := RGBAColor{Red: 255, Green: 255, Blue: 255, Alpha: 1.0}
+
+	luminanceTests := []struct {
+		color    RGBAColor
+		expected float64
+	}{
+		{black, 0},
+		{white, 1},
+		{RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, 0.2126},
+		{RGBAColor{Red: 0, Green: 255, Blue: 0, Alpha: 1.0}, 0.7152},
+		{RGBAColor{Red: 119, Green: 119, Blue: 119, Alpha: 0.5}, 0.184475},
+	}
+
+	for _, tt := range luminanceTests {
+		if got := tt.color.Luminance(); math.Abs(got-tt.expected) > 1e-6 {
+			t.Errorf("%v.Luminance() = %v, want %v", tt.color, got, tt.expected)
+		}
+	}
+
+	ratioTests := []struct {
+		a, b     RGBAColor
+		expected float64
+	}{
+		{black, white, 21},
+		{white, black, 21},
+		{white, white, 1},
+		{RGBAColor{Red: 119, Green: 119, Blue: 119, Alpha: 1.0}, white, 4.478},
+		{RGBAColor{Red: 118, Green: 118, Blue: 118, Alpha: 1.0}, white, 4.542},
+		{RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, white, 3.998},
+	}
+
+	for _, tt := range ratioTests {
+		if got := tt.a.ContrastRatio(tt.b); math.Abs(got-tt.expected) > 1e-3 {
+			t.Errorf("%v.ContrastRatio(%v) = %v, want %v", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
+
+// TestContrastPair tests the ContrastPair and LowContrastPair functions
+func TestContrastPair(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	for _, ratio := range []float64{ContrastAALargeText, ContrastAA, ContrastAAA, 12, 21} {
+		for i := 0; i < 100; i++ {
+			foreground, background := ContrastPair(ratio)
+			if got := foreground.ContrastRatio(background); got < ratio {
+				t.Errorf("ContrastPair(%v) = %v, %v with ratio %v, want at least %v", ratio, foreground, background, got, ratio)
+			}
+		}
+	}
+
+	for _, threshold := range []float64{ContrastAALargeText, ContrastAA, ContrastAAA, 1.1, 21} {
+		for i := 0; i < 100; i++ {
+			foreground, background := LowContrastPair(threshold)
+			if got := foreground.ContrastRatio(background); got >= threshold || got < threshold-0.25 {
+				t.Errorf("LowContrastPair(%v) = %v, %v with ratio %v, want just below %v", threshold, foreground, background, got, threshold)
+			}
+			if foreground == background {
+				t.Errorf("LowContrastPair(%v) returned the same color %v twice", threshold, foreground)
+			}
+		}
+	}
+
+	// Test that the options apply to the colors
+	for i := 0; i < 100; i++ {
+		foreground, background := ContrastPair(ContrastAA, WithGrayscale(true))
+		if foreground.Red != foreground.Blue || background.Red != background.Blue {
+			t.Errorf("ContrastPair(ContrastAA, WithGrayscale(true)) = %v, %v, want grays", foreground, background)
+		}
+	}
+
+	// Test edge cases
+	if foreground, background := ContrastPair(math.NaN()); foreground.Alpha != 1.0 || background.Alpha != 1.0 {
+		t.Errorf("ContrastPair(NaN) = %v, %v, want opaque colors", foreground, background)
+	}
+}
+
+// TestNamed tests the Named function
+func TestNamed(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	name, value := Named()
+	expectedName := "paleturquoise"
+	expectedValue := RGBAColor{Red: 175, Green: 238, Blue: 238, Alpha: 1.0}
+	if name != expectedName || value != expectedValue {
+		t.Errorf("Named() = %v, %v, want %v, %v", name, value, expectedName, expectedValue)
+	}
+
+	// Test that localized names share the values of the base locale
+	name, value = Named(WithLocale("de"))
+	expectedName = "Dunkles Schieferblau"
+	expectedValue = RGBAColor{Red: 72, Green: 61, Blue: 139, Alpha: 1.0}
+	if name != expectedName || value != expectedValue {
+		t.Errorf("Named(WithLocale(\"de\")) = %v, %v, want %v, %v", name, value, expectedName, expectedValue)
+	}
+
+	// Test that every CSS keyword parses to its value
+	for i := 0; i < 100; i++ {
+		name, value := Named()
+		if parsed, err := Parse(name); err != nil || parsed != value {
+			t.Errorf("Parse(%q) = %v, %v, want %v", name, parsed, err, value)
+		}
+	}
+
+	// Test that the dataset is the full CSS Color Module Level 4 set
+	if colors := loadNamedColors("en"); len(colors) != 148 {
+		t.Errorf("loadNamedColors(\"en\") returned %d colors, want 148", len(colors))
+	}
+}
+
+// TestNearestName tests the NearestName function
+func TestNearestName(t *testing.T) {
+	tests := []struct {
+		color    Color
+		expected string
+	}{
+		{RGBAColor{Red: 0, Green: 128, Blue: 128, Alpha: 1.0}, "teal"},
+		{RGBAColor{Red: 2, Green: 130, Blue: 125, Alpha: 0.5}, "teal"},
+		{RGBAColor{Red: 0, Green: 255, Blue: 255, Alpha: 1.0}, "aqua"},
+		{RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 1.0}, "gray"},
+		{RGBAColor{Red: 250, Green: 5, Blue: 5, Alpha: 1.0}, "red"},
+		{HSLAColor{Hue: 210, Saturation: 44, Lightness: 49, Alpha: 1.0}, "steelblue"},
+		{CMYKColor{Cyan: 0, Magenta: 0, Yellow: 0, Key: 100}, "black"},
+	}
+
+	for _, tt := range tests {
+		if got := NearestName(tt.color); got != tt.expected {
+			t.Errorf("NearestName(%v) = %v, want %v", tt.color, got, tt.expected)
+		}
+	}
+
+	// Test with a localized name
+	if got := NearestName(RGBAColor{Red: 0, Green: 128, Blue: 128, Alpha: 1.0}, WithLocale("de")); got != "Blaugrün" {
+		t.Errorf("NearestName(teal, WithLocale(\"de\")) = %v, want Blaugrün", got)
+	}
+
+	// Test that the value of every named color is nearest to itself or an alias with the same value
+	for _, named := range loadNamedColors("en") {
+		nearest, _ := Parse(NearestName(named.value))
+		if nearest != named.value {
+			t.Errorf("NearestName(%v) = %v, want %v or an alias", named.value, nearest, named.keyword)
+		}
+	}
+}
+
+// TestColorSpaces tests the conversions between RGBA and the additional color spaces
+func TestColorSpaces(t *testing.T) {
+	steelBlue := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 1.0}
+
+	tests := []struct {
+		color    Color
+		expected string
+	}{
+		{steelBlue.ToHSVA(), "hsv(207°, 61%, 71%)"},
+		{steelBlue.ToHWBA(), "hwb(207 27% 29%)"},
+		{steelBlue.ToXYZ(), "color(xyz-d65 0.1875 0.2056 0.4616)"},
+		{steelBlue.ToLab(), "lab(51.99% -8.36 -32.83)"},
+		{steelBlue.ToLCH(), "lch(51.99% 33.88 255.71)"},
+		{steelBlue.ToOKLab(), "oklab(0.588 -0.0408 -0.0906)"},
+		{steelBlue.ToOKLCH(), "oklch(0.588 0.0993 245.74)"},
+		{RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 0.5}.ToOKLCH(), "oklch(0.5999 0 0 / 0.5)"},
+		{RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 0.25}.ToHSVA(), "hsva(0°, 0%, 50%, 0.25)"},
+		{RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 0.25}.ToHWBA(), "hwb(0 50% 50% / 0.25)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.color.String(); got != tt.expected {
+			t.Errorf("%#v.String() = %v, want %v", tt.color, got, tt.expected)
+		}
+	}
+
+	abs := func(n int) int {
+		if n < 0 {
+			return -n
+		}
+		return n
+	}
+
+	// Test that the CIE color spaces convert back to the same RGBA color, and HSV and HWB within 3 per channel
+	for red := 0; red < 256; red += 15 {
+		for green := 0; green < 256; green += 15 {
+			for blue := 0; blue < 256; blue += 15 {
+				c := RGBAColor{Red: red, Green: green, Blue: blue, Alpha: 0.5}
+				for _, converted := range []Color{c.ToXYZ(), c.ToLab(), c.ToLCH(), c.ToOKLab(), c.ToOKLCH(), c.ToOKLCH().ToOKLab(), c.ToLCH().ToLab()} {
+					if got := converted.ToRGBA(); got != c {
+						t.Errorf("%v converted to %v and back = %v", c, converted, got)
+					}
+				}
+				for _, converted := range []Color{c.ToHSVA(), c.ToHWBA()} {
+					if got := converted.ToRGBA(); max(abs(got.Red-c.Red), abs(got.Green-c.Green), abs(got.Blue-c.Blue)) > 3 {
+						t.Errorf("%v converted to %v and back = %v", c, converted, got)
+					}
+				}
+			}
+		}
+	}
+
+	// Test HWB colors whose whiteness and blackness add up to more than 100%
+	if got := (HWBAColor{Hue: 120, Whiteness: 60, Blackness: 60, Alpha: 1.0}).ToRGBA(); got != (RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 1.0}) {
+		t.Errorf("hwb(120 60%% 60%%).ToRGBA() = %v, want rgb(128, 128, 128)", got)
+	}
+}
+
+// TestGamutMapping tests the conversion of colors outside of the sRGB gamut
+func TestGamutMapping(t *testing.T) {
+	tests := []struct {
+		color    Color
+		expected RGBAColor
+	}{
+		{OKLCHColor{L: 0.7, C: 0.4, H: 150, Alpha: 1.0}, RGBAColor{Red: 0, Green: 194, Blue: 72, Alpha: 1.0}},
+		{LCHColor{L: 50, C: 150, H: 30, Alpha: 0.5}, RGBAColor{Red: 248, Green: 0, Blue: 71, Alpha: 0.5}},
+		{OKLCHColor{L: 1.2, C: 0.1, H: 0, Alpha: 1.0}, RGBAColor{Red: 255, Green: 255, Blue: 255, Alpha: 1.0}},
+		{OKLabColor{L: -0.1, A: 0.1, B: 0, Alpha: 1.0}, RGBAColor{Red: 0, Green: 0, Blue: 0, Alpha: 1.0}},
+	}
+
+	for _, tt := range tests {
+		if got := tt.color.ToRGBA(); got != tt.expected {
+			t.Errorf("%v.ToRGBA() = %v, want %v", tt.color, got, tt.expected)
+		}
+	}
+
+	// Gamut mapping reduces the chroma, and keeps the lightness and hue within a just noticeable difference
+	// (0.02 in OKLab) plus the rounding of the RGBA channels
+	for hue := 0.0; hue < 360; hue += 10 {
+		c := OKLCHColor{L: 0.6, C: 0.35, H: hue, Alpha: 1.0}
+		mapped := c.ToRGBA().ToOKLCH()
+		reduced := OKLCHColor{L: c.L, C: mapped.C, H: c.H, Alpha: 1.0}.ToOKLab()
+		if distance := labDistance(toOKLab(c.ToRGBA()), [3]float64{reduced.L, reduced.A, reduced.B}); mapped.C > c.C || distance > 0.025 {
+			t.Errorf("%v.ToRGBA() = %v, want a color with a similar lightness and hue", c, mapped)
+		}
+	}
+}
+
+// TestDeltaE2000 tests the DeltaE2000 function with the test data of Sharma, Wu and Dalal (2005)
+func TestDeltaE2000(t *testing.T) {
+	tests := []struct {
+		a, b     LabColor
+		expected float64
+	}{
+		{LabColor{50, 2.6772, -79.7751, 1}, LabColor{50, 0, -82.7485, 1}, 2.0425},
+		{LabColor{50, 0, 0, 1}, LabColor{50, -1, 2, 1}, 2.3669},
+		{LabColor{50, 2.49, -0.001, 1}, LabColor{50, -2.49, 0.0011, 1}, 7.2195},
+		{LabColor{50, 2.5, 0, 1}, LabColor{73, 25, -18, 1}, 27.1492},
+		{LabColor{60.2574, -34.0099, 36.2677, 1}, LabColor{60.4626, -34.1751, 39.4387, 1}, 1.2644},
+		{LabColor{22.7233, 20.0904, -46.694, 1}, LabColor{23.0331, 14.973, -42.5619, 1}, 2.0373},
+		{LabColor{90.9257, -0.5406, -0.9208, 1}, LabColor{88.6381, -0.8985, -0.7239, 1}, 1.5381},
+	}
+
+	for _, tt := range tests {
+		if got := DeltaE2000(tt.a, tt.b); math.Abs(got-tt.expected) > 1e-4 {
+			t.Errorf("DeltaE2000(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.expected)
+		}
+		if got := DeltaE2000(tt.b, tt.a); math.Abs(got-tt.expected) > 1e-4 {
+			t.Errorf("DeltaE2000(%v, %v) = %v, want %v", tt.b, tt.a, got, tt.expected)
+		}
+	}
+
+	// Test that the difference works across color spaces
+	steelBlue := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 1.0}
+	if got := DeltaE2000(steelBlue, steelBlue.ToOKLCH()); got > 1e-9 {
+		t.Errorf("DeltaE2000(%v, %v) = %v, want 0", steelBlue, steelBlue.ToOKLCH(), got)
+	}
+	if got := DeltaE2000(steelBlue, CMYKColor{Cyan: 0, Magenta: 0, Yellow: 0, Key: 100}); got < 30 {
+		t.Errorf("DeltaE2000(%v, black) = %v, want a large difference", steelBlue, got)
+	}
+}
+
+// TestColorSpaceGenerators tests the random generators of the additional color spaces
+func TestColorSpaceGenerators(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	generated := []Color{HSV(), HWB(), XYZ(), Lab(), LCH(), OKLab(), OKLCH()}
+	expected := []string{
+		"hsv(218°, 37%, 98%)",
+		"hwb(146 40% 36%)",
+		"color(xyz-d65 0.2026 0.221 0.5784)",
+		"lab(24.08% -12.5 29.04)",
+		"lch(71.11% 39.17 100.81)",
+		"oklab(0.469 0.1669 0.0785)",
+		"oklch(0.8833 0.148 175.55)",
+	}
+
+	for i, c := range generated {
+		if c.String() != expected[i] {
+			t.Errorf("generated color %d = %v, want %v", i, c, expected[i])
+		}
+	}
+
+	// Test that the generators use the range options
+	for i := 0; i < 100; i++ {
+		c := OKLCH(WithGrayscale(true), WithAlphaRange(0.2, 0.4))
+		if c.C > 1e-4 || c.Alpha < 0.2 || c.Alpha > 0.4 {
+			t.Errorf("OKLCH(WithGrayscale(true), WithAlphaRange(0.2, 0.4)) = %v, want a translucent gray", c)
+		}
+		if lab := Lab(WithPreset(PresetDark)); lab.L > 55 || lab.Alpha != 1.0 {
+			t.Errorf("Lab(WithPreset(PresetDark)) = %v, want an opaque dark color", lab)
+		}
+	}
+}
+
+// TestImageColor tests the image/color interoperability of the color types
+func TestImageColor(t *testing.T) {
+	red, green, blue, alpha := RGBAColor{Red: 255, Green: 136, Blue: 0, Alpha: 0.5}.RGBA()
+	if red != 0x8000 || green != 0x4444 || blue != 0 || alpha != 0x8000 {
+		t.Errorf("RGBA() = %#x, %#x, %#x, %#x, want alpha-premultiplied channels", red, green, blue, alpha)
+	}
+
+	// Every color type can be used as an image/color.Color
+	steelBlue := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 1.0}
+	colors := []imagecolor.Color{steelBlue, steelBlue.ToHSLA(), steelBlue.ToCMYK(), steelBlue.ToHSVA(), steelBlue.ToHWBA(),
+		steelBlue.ToXYZ(), steelBlue.ToLab(), steelBlue.ToLCH(), steelBlue.ToOKLab(), steelBlue.ToOKLCH()}
+	for _, c := range colors {
+		converted := imagecolor.RGBAModel.Convert(c).(imagecolor.RGBA)
+		if diff := max(absDiff(converted.R, 70), absDiff(converted.G, 130), absDiff(converted.B, 180)); diff > 3 || converted.A != 255 {
+			t.Errorf("RGBAModel.Convert(%v) = %v, want about %v", c, converted, steelBlue)
+		}
+	}
+
+	// Test that colors can be drawn into an image and read back
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, RGBAColor{Red: 255, Green: 136, Blue: 0, Alpha: 0.6})
+	if got := FromColor(img.At(0, 0)); got.Red != 255 || got.Green != 136 || got.Blue != 0 || got.Alpha != 0.6 {
+		t.Errorf("FromColor(%v) = %v, want rgba(255, 136, 0, 0.60)", img.At(0, 0), got)
+	}
+
+	fromTests := []struct {
+		input    imagecolor.Color
+		expected RGBAColor
+	}{
+		{imagecolor.NRGBA{R: 255, G: 136, B: 0, A: 255}, RGBAColor{Red: 255, Green: 136, Blue: 0, Alpha: 1.0}},
+		{imagecolor.RGBA{R: 128, G: 68, B: 0, A: 128}, RGBAColor{Red: 255, Green: 135, Blue: 0, Alpha: 128.0 / 255}},
+		{imagecolor.Gray{Y: 128}, RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 1.0}},
+		{imagecolor.Transparent, RGBAColor{Red: 0, Green: 0, Blue: 0, Alpha: 0}},
+		{HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 0.5}, RGBAColor{Red: 51, Green: 102, Blue: 153, Alpha: 0.5}},
+	}
+
+	for _, tt := range fromTests {
+		if got := FromColor(tt.input); got != tt.expected {
+			t.Errorf("FromColor(%v) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+}
+
+// TestEncoding tests the text, JSON and database encodings of the color types
+func TestEncoding(t *testing.T) {
+	type palette struct {
+		Primary    RGBAColor  `json:"primary"`
+		Secondary  HSLAColor  `json:"secondary"`
+		Print      CMYKColor  `json:"print"`
+		Design     OKLCHColor `json:"design"`
+		Background HWBAColor  `json:"background"`
+	}
+
+	original := palette{
+		Primary:    RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 0.5},
+		Secondary:  HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 1.0},
+		Print:      CMYKColor{Cyan: 67, Magenta: 33, Yellow: 0, Key: 40},
+		Design:     OKLCHColor{L: 0.588, C: 0.0993, H: 245.74, Alpha: 1.0},
+		Background: HWBAColor{Hue: 207, Whiteness: 27, Blackness: 29, Alpha: 0.25},
+	}
+
+	data, err := json.Marshal(original)
+	if err != nil {
+		t.Fatalf("json.Marshal() returned error: %v", err)
+	}
+	expectedJSON := `{"primary":"rgba(70, 130, 180, 0.5)","secondary":"hsl(210°, 50%, 40%)","print":"cmyk(67%, 33%, 0%, 40%)",` +
+		`"design":"oklch(0.588 0.0993 245.74)","background":"hwb(207 27% 29% / 0.25)"}`
+	if string(data) != expectedJSON {
+		t.Errorf("json.Marshal() = %s, want %s", data, expectedJSON)
+	}
+
+	var decoded palette
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal() returned error: %v", err)
+	}
+	if decoded != original {
+		t.Errorf("json.Unmarshal() = %+v, want %+v", decoded, original)
+	}
+
+	// Test that the text form is lossless
+	lossless := []Color{
+		RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 0.125},
+		HSVAColor{Hue: 207, Saturation: 61, Brightness: 71, Alpha: 1.0 / 3},
+		LabColor{L: 52.46512345678, A: -4.0701, B: -32.19876543, Alpha: 0.333},
+		OKLabColor{L: 0.58801234, A: -0.04039, B: -0.0907654321, Alpha: 1.0},
+	}
+	for _, c := range lossless {
+		text, _ := c.(encoding.TextMarshaler).MarshalText()
+		decoded, err := Parse(string(text))
+		if err != nil || decoded != c {
+			t.Errorf("Parse(MarshalText(%#v)) = %#v, %v, want the same color", c, decoded, err)
+		}
+	}
+
+	// Test that colors of other types are converted
+	var hsla HSLAColor
+	if err := hsla.UnmarshalText([]byte("#336699")); err != nil || hsla != (HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 1.0}) {
+		t.Errorf("UnmarshalText(#336699) = %v, %v, want hsl(210°, 50%%, 40%%)", hsla, err)
+	}
+	var lab LabColor
+	if err := lab.UnmarshalText([]byte("teal")); err != nil || lab.String() != "lab(47.99% -30.39 -8.98)" {
+		t.Errorf("UnmarshalText(teal) = %v, %v, want lab(47.99%% -30.39 -8.98)", lab, err)
+	}
+	if err := json.Unmarshal([]byte(`{"primary":"not a color"}`), &decoded); !errors.Is(err, ErrInvalidColor) {
+		t.Errorf("json.Unmarshal() with an invalid color returned %v, want ErrInvalidColor", err)
+	}
+}
+
+// TestSQL tests the database/sql Scanner and driver.Valuer implementations of the color types
+func TestSQL(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	for i := 0; i < 100; i++ {
+		rgba := RGBA()
+		value, err := rgba.Value()
+		if err != nil {
+			t.Fatalf("Value() returned error: %v", err)
+		}
+
+		var scanned RGBAColor
+		if err := scanned.Scan(value); err != nil || scanned != rgba {
+			t.Errorf("Scan(%v) = %v, %v, want %v", value, scanned, err, rgba)
+		}
+
+		oklch := OKLCH()
+		value, _ = oklch.Value()
+		var scannedOKLCH OKLCHColor
+		if err := scannedOKLCH.Scan([]byte(value.(string))); err != nil || scannedOKLCH != oklch {
+			t.Errorf("Scan(%v) = %v, %v, want %v", value, scannedOKLCH, err, oklch)
+		}
+	}
+
+	cmyk := CMYKColor{Cyan: 1, Magenta: 2, Yellow: 3, Key: 4}
+	if err := cmyk.Scan(nil); err != nil || cmyk != (CMYKColor{}) {
+		t.Errorf("Scan(nil) = %v, %v, want the zero value", cmyk, err)
+	}
+	if err := cmyk.Scan(42); !errors.Is(err, ErrInvalidColor) {
+		t.Errorf("Scan(42) returned %v, want ErrInvalidColor", err)
+	}
+}
+
+// TestSimulate tests the Simulate method of RGBAColor
+func TestSimulate(t *testing.T) {
+	red := RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 0.5}
+	green := RGBAColor{Red: 0, Green: 255, Blue: 0, Alpha: 1.0}
+	white := RGBAColor{Red: 255, Green: 255, Blue: 255, Alpha: 1.0}
+	black := RGBAColor{Red: 0, Green: 0, Blue: 0, Alpha: 1.0}
+
+	for _, deficiency := range []string{DeficiencyProtanopia, DeficiencyDeuteranopia, DeficiencyTritanopia, DeficiencyAchromatopsia} {
+		// Neutral colors are seen the same with every deficiency
+		if got := white.Simulate(deficiency); got != white {
+			t.Errorf("Simulate(%s) of white = %v, want %v", deficiency, got, white)
+		}
+		if got := black.Simulate(deficiency); got != black {
+			t.Errorf("Simulate(%s) of black = %v, want %v", deficiency, got, black)
+		}
+		if got := red.Simulate(deficiency); got.Alpha != red.Alpha {
+			t.Errorf("Simulate(%s) alpha = %v, want %v", deficiency, got.Alpha, red.Alpha)
+		}
+	}
+
+	// Red and green are both seen as shades of yellow without red or green cones
+	for _, deficiency := range []string{DeficiencyProtanopia, DeficiencyDeuteranopia} {
+		for _, c := range []RGBAColor{red, green} {
+			if got := c.Simulate(deficiency); max(got.Red-got.Green, got.Green-got.Red) > 1 || got.Blue > got.Red/4 {
+				t.Errorf("Simulate(%s) of %v = %v, want a shade of yellow", deficiency, c, got)
+			}
+		}
+	}
+
+	simulationTests := []struct {
+		deficiency string
+		input      RGBAColor
+		expected   RGBAColor
+	}{
+		{DeficiencyProtanopia, RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, RGBAColor{Red: 94, Green: 94, Blue: 13, Alpha: 1.0}},
+		{DeficiencyDeuteranopia, RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, RGBAColor{Red: 147, Green: 147, Blue: 0, Alpha: 1.0}},
+		{DeficiencyTritanopia, RGBAColor{Red: 0, Green: 0, Blue: 255, Alpha: 1.0}, RGBAColor{Red: 0, Green: 98, Blue: 136, Alpha: 1.0}},
+		{DeficiencyAchromatopsia, RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, RGBAColor{Red: 127, Green: 127, Blue: 127, Alpha: 1.0}},
+		{"unknown", RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}},
+	}
+
+	for _, tt := range simulationTests {
+		if got := tt.input.Simulate(tt.deficiency); got != tt.expected {
+			t.Errorf("Simulate(%s) of %v = %v, want %v", tt.deficiency, tt.input, got, tt.expected)
+		}
+	}
+
+	// Achromatopsia keeps the luminance of the color
+	setupTest(t)
+	defer teardownTest(t)
+	for i := 0; i < 100; i++ {
+		c := RGB()
+		gray := c.Simulate(DeficiencyAchromatopsia)
+		if gray.Red != gray.Green || gray.Green != gray.Blue || math.Abs(gray.Luminance()-c.Luminance()) > 0.005 {
+			t.Errorf("Simulate(achromatopsia) of %v = %v, want a gray with luminance %.3f", c, gray, c.Luminance())
+		}
+	}
+}
+
+// TestDistinguishable tests the Distinguishable function
+func TestDistinguishable(t *testing.T) {
+	// Red and green of the same lightness are confused with protanopia and deuteranopia
+	redGreen := []RGBAColor{{Red: 200, Green: 50, Blue: 50, Alpha: 1.0}, {Red: 50, Green: 150, Blue: 50, Alpha: 1.0}}
+	if Distinguishable(redGreen) {
+		t.Errorf("Distinguishable(%v) = true, want false", redGreen)
+	}
+	if !Distinguishable(redGreen, WithDeficiencies()) {
+		t.Errorf("Distinguishable(%v) with normal vision = false, want true", redGreen)
+	}
+	if Distinguishable(redGreen, WithDeficiencies(DeficiencyDeuteranopia, "unknown")) {
+		t.Errorf("Distinguishable(%v) with deuteranopia = true, want false", redGreen)
+	}
+
+	// Blue and yellow of very different lightness are distinguishable with every deficiency
+	blueYellow := []HSLAColor{{Hue: 240, Saturation: 100, Lightness: 25, Alpha: 1.0}, {Hue: 60, Saturation: 100, Lightness: 80, Alpha: 1.0}}
+	if !Distinguishable(blueYellow) {
+		t.Errorf("Distinguishable(%v) = false, want true", blueYellow)
+	}
+	if Distinguishable(blueYellow, WithMinDeltaE(100)) {
+		t.Errorf("Distinguishable(%v) with a minimum ΔE2000 of 100 = true, want false", blueYellow)
+	}
+
+	// Fewer than two colors are always distinguishable
+	if !Distinguishable([]RGBAColor{}) || !Distinguishable(redGreen[:1]) {
+		t.Errorf("Distinguishable() of fewer than two colors = false, want true")
+	}
+}
+
+// TestSafePalette tests the SafePalette function
+func TestSafePalette(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	if got := SafePalette(0); len(got) != 0 {
+		t.Errorf("SafePalette(0) = %v, want an empty palette", got)
+	}
+
+	for i := 0; i < 5; i++ {
+		for _, n := range []int{1, 3, 5, 8} {
+			palette := SafePalette(n)
+			if len(palette) == 0 || len(palette) > n || (n <= 5 && len(palette) != n) {
+				t.Errorf("SafePalette(%d) returned %d colors", n, len(palette))
+			}
+			if !Distinguishable(palette) {
+				t.Errorf("SafePalette(%d) = %v, which is not distinguishable", n, palette)
+			}
+		}
+	}
+
+	// Checking fewer deficiencies allows more colors
+	palette := SafePalette(8, WithDeficiencies(DeficiencyDeuteranopia))
+	if len(palette) != 8 || !Distinguishable(palette, WithDeficiencies(DeficiencyDeuteranopia)) {
+		t.Errorf("SafePalette(8) with deuteranopia = %v, want 8 distinguishable colors", palette)
+	}
+
+	// The palette starts with the base color and stays within the range options
+	base := HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 0.8}
+	palette = SafePalette(4, WithPaletteBase(base), WithLightnessRange(20, 80))
+	if len(palette) != 4 || palette[0] != base {
+		t.Errorf("SafePalette(4) with base %v = %v", base, palette)
+	}
+	for _, c := range palette {
+		if c.Lightness < 20 || c.Lightness > 80 || c.Alpha != base.Alpha {
+			t.Errorf("SafePalette(4) color %v is outside the lightness range or has a different alpha", c)
+		}
+	}
+}
+
+// TestGradient tests the LinearGradient and RadialGradient functions
+func TestGradient(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	if got := LinearGradient(3).String(); got != "linear-gradient(269deg, #bffb66 0%, #a4812f 50%, #87c830 100%)" {
+		t.Errorf("LinearGradient(3) = %s, want linear-gradient(269deg, #bffb66 0%%, #a4812f 50%%, #87c830 100%%)", got)
+	}
+
+	for i := 0; i < 100; i++ {
+		for _, n := range []int{-1, 2, 5} {
+			for _, gradient := range []Gradient{LinearGradient(n, WithPreset(PresetPastel)), RadialGradient(n, WithPreset(PresetPastel))} {
+				if len(gradient.Stops) != max(n, 2) || gradient.Angle < 0 || gradient.Angle >= 360 {
+					t.Fatalf("%v has %d stops, want %d", gradient, len(gradient.Stops), max(n, 2))
+				}
+				for j, stop := range gradient.Stops {
+					if lightness := stop.Color.ToHSLA().Lightness; lightness < 78 || lightness > 92 || stop.Color.Alpha != 1.0 {
+						t.Errorf("%v stop %d = %v, want an opaque pastel color", gradient, j, stop.Color)
+					}
+					if expected := float64(j) / float64(len(gradient.Stops)-1); stop.Position != expected {
+						t.Errorf("%v stop %d position = %v, want %v", gradient, j, stop.Position, expected)
+					}
+				}
+			}
+		}
+	}
+
+	radial := RadialGradient(4, WithAlphaRange(0.5, 0.5), WithGradientAngle(45))
+	if !strings.HasPrefix(radial.String(), "radial-gradient(circle, #") || !strings.Contains(radial.String(), "80 33.33%, #") || radial.Angle != 0 {
+		t.Errorf("RadialGradient(4) = %v, want a circle with 8 digit hex stops", radial)
+	}
+	if got := LinearGradient(2, WithGradientAngle(-90)).Angle; got != 270 {
+		t.Errorf("LinearGradient(2) with angle -90 has angle %d, want 270", got)
+	}
+
+	gradient := Gradient{Type: GradientLinear, Angle: 90, Stops: []GradientStop{
+		{Color: RGBAColor{Red: 255, Green: 136, Blue: 0, Alpha: 1.0}, Position: 0},
+		{Color: RGBAColor{Red: 51, Green: 102, Blue: 153, Alpha: 0.5}, Position: 1},
+	}}
+	if got := gradient.String(); got != "linear-gradient(90deg, #ff8800 0%, #33669980 100%)" {
+		t.Errorf("String() = %s, want linear-gradient(90deg, #ff8800 0%%, #33669980 100%%)", got)
+	}
+
+	tokens, err := gradient.DesignTokens()
+	expectedTokens := `{
+  "$type": "gradient",
+  "$value": [
+    {
+      "color": "#ff8800",
+      "position": 0
+    },
+    {
+      "color": "#33669980",
+      "position": 1
+    }
+  ]
+}`
+	if err != nil || string(tokens) != expectedTokens {
+		t.Errorf("DesignTokens() = %s, %v, want %s", tokens, err, expectedTokens)
+	}
+}
+
+// TestTheme tests the Theme function
+func TestTheme(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	for i := 0; i < 300; i++ {
+		theme := Theme(WithGrayscale(i%3 == 0))
+		for _, colors := range []ThemeColors{theme.Light, theme.Dark} {
+			for _, role := range colors.roles() {
+				minRatio := ContrastAA
+				if role.name == "text" {
+					minRatio = ContrastAAA
+				}
+				if role.name != "surface" && role.color.ContrastRatio(colors.Surface) < minRatio {
+					t.Errorf("Theme() %s %v has a contrast ratio of %.2f with the surface %v, want at least %.1f",
+						role.name, role.color, role.color.ContrastRatio(colors.Surface), colors.Surface, minRatio)
+				}
+			}
+		}
+		if theme.Light.Surface.Luminance() < theme.Dark.Surface.Luminance() {
+			t.Errorf("Theme() light surface %v is darker than the dark surface %v", theme.Light.Surface, theme.Dark.Surface)
+		}
+	}
+
+	theme := Theme(WithPaletteBase(HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 1.0}))
+	if theme.Light.Primary != (RGBAColor{Red: 51, Green: 102, Blue: 153, Alpha: 1.0}) || theme.Light.Secondary.ToHSLA().Hue != 0 {
+		t.Errorf("Theme() with base hsl(210°, 50%%, 40%%) = %v", theme)
+	}
+
+	css := theme.CSS()
+	for _, property := range []string{":root {\n  --color-primary: #336699;\n  --color-secondary: #", "\n  --color-surface: #", "\n  --color-text: #",
+		"\n  --color-error: #", "}\n\n@media (prefers-color-scheme: dark) {\n  :root {\n    --color-primary: #"} {
+		if !strings.Contains(css, property) {
+			t.Errorf("CSS() = %s, want it to contain %q", css, property)
+		}
+	}
+
+	data, err := theme.DesignTokens()
+	var tokens map[string]map[string]map[string]map[string]string
+	if err != nil || json.Unmarshal(data, &tokens) != nil {
+		t.Fatalf("DesignTokens() = %s, %v, want valid JSON", data, err)
+	}
+	for _, mode := range []string{"light", "dark"} {
+		for _, role := range []string{"primary", "secondary", "surface", "text", "error"} {
+			if token := tokens["color"][mode][role]; token["$type"] != "color" || !strings.HasPrefix(token["$value"], "#") {
+				t.Errorf("DesignTokens() color.%s.%s = %v, want a color token", mode, role, token)
+			}
+		}
+	}
+	if got := tokens["color"]["dark"]["surface"]["$value"]; got != theme.Dark.Surface.Hex() {
+		t.Errorf("DesignTokens() color.dark.surface = %s, want %s", got, theme.Dark.Surface.Hex())
+	}
+}
+
+// TestFromString tests the FromString and FromBytes functions
+func TestFromString(t *testing.T) {
+	// The colors must never change, so that stored or shared inputs keep their colors
+	stableTests := []struct {
+		input    string
+		opts     []OptionFunc
+		expected RGBAColor
+	}{
+		{"", nil, RGBAColor{Red: 20, Green: 36, Blue: 76, Alpha: 1.0}},
+		{"alice", nil, RGBAColor{Red: 175, Green: 169, Blue: 79, Alpha: 1.0}},
+		{"bob", nil, RGBAColor{Red: 218, Green: 23, Blue: 77, Alpha: 1.0}},
+		{"alice@example.com", nil, RGBAColor{Red: 191, Green: 36, Blue: 173, Alpha: 1.0}},
+		{"alice", []OptionFunc{WithPreset(PresetPastel)}, RGBAColor{Red: 216, Green: 238, Blue: 223, Alpha: 1.0}},
+		{"bob", []OptionFunc{WithLightnessRange(20, 40), WithAlphaRange(0.5, 1)}, RGBAColor{Red: 84, Green: 24, Blue: 72, Alpha: 0.77}},
+		{"alice@example.com", []OptionFunc{WithGrayscale(true)}, RGBAColor{Red: 227, Green: 227, Blue: 227, Alpha: 1.0}},
+		{"alice", []OptionFunc{WithHueRange(330, 30)}, RGBAColor{Red: 211, Green: 118, Blue: 100, Alpha: 1.0}},
+		{"bob", []OptionFunc{WithHueRange(200, 220), WithLightnessRange(30, 70)}, RGBAColor{Red: 116, Green: 169, Blue: 216, Alpha: 1.0}},
+		{"carol", []OptionFunc{WithHueRange(0, 359), WithSaturationRange(40, 60)}, RGBAColor{Red: 215, Green: 243, Blue: 244, Alpha: 1.0}},
+		{"bob", []OptionFunc{WithPreset(PresetVivid)}, RGBAColor{Red: 199, Green: 10, Blue: 161, Alpha: 1.0}},
+		{"carol", []OptionFunc{WithPreset(PresetDark)}, RGBAColor{Red: 26, Green: 102, Blue: 112, Alpha: 1.0}},
+		{"dave", []OptionFunc{WithPreset(PresetLight), WithAlphaRange(0.25, 0.75)}, RGBAColor{Red: 226, Green: 206, Blue: 177, Alpha: 0.67}},
+	}
+
+	for _, tt := range stableTests {
+		if got := FromString(tt.input, tt.opts...); got != tt.expected {
+			t.Errorf("FromString(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+		if got := FromBytes([]byte(tt.input), tt.opts...); got != tt.expected {
+			t.Errorf("FromBytes(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+
+	// The colors do not depend on the random source
+	expected := FromString("alice", WithHueRange(180, 240))
+	for _, seed := range []uint64{1, 2, 3} {
+		random.SetRandomSource(rand.NewPCG(seed, seed))
+		_ = RGBA()
+		if got := FromString("alice", WithHueRange(180, 240)); got != expected {
+			t.Errorf("FromString(alice) = %v after seeding with %d, want %v", got, seed, expected)
+		}
+	}
+	random.ResetRandomSource()
+
+	// The colors stay within the range options
+	for i := 0; i < 1000; i++ {
+		hsla := FromString(fmt.Sprintf("user-%d", i), WithHueRange(330, 30), WithSaturationRange(50, 70), WithLightnessRange(30, 50)).ToHSLA()
+		if (hsla.Hue > 32 && hsla.Hue < 328) || hsla.Saturation < 45 || hsla.Saturation > 75 || hsla.Lightness < 29 || hsla.Lightness > 51 {
+			t.Errorf("FromString(user-%d) = %v, want a color within the ranges", i, hsla)
+		}
+	}
+}
+
+// TestANSI tests the ANSI function and the ANSI method of RGBAColor
+func TestANSI(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	if got := ANSI(); got != "\x1b[38;2;157;191;251m" {
+		t.Errorf("ANSI() = %q, want %q", got, "\x1b[38;2;157;191;251m")
+	}
+
+	sequencePatterns := []struct {
+		opts    []OptionFunc
+		pattern *regexp.Regexp
+	}{
+		{nil, regexp.MustCompile(`^\x1b\[38;2;(\d+);(\d+);(\d+)m$`)},
+		{[]OptionFunc{WithANSIBackground(true)}, regexp.MustCompile(`^\x1b\[48;2;(\d+);(\d+);(\d+)m$`)},
+		{[]OptionFunc{WithANSIMode(ANSIMode256)}, regexp.MustCompile(`^\x1b\[38;5;(\d+)m$`)},
+		{[]OptionFunc{WithANSIMode(ANSIMode256), WithANSIBackground(true)}, regexp.MustCompile(`^\x1b\[48;5;(\d+)m$`)},
+		{[]OptionFunc{WithANSIMode(ANSIMode16)}, regexp.MustCompile(`^\x1b\[(3[0-7]|9[0-7])m$`)},
+		{[]OptionFunc{WithANSIMode(ANSIMode16), WithANSIBackground(true)}, regexp.MustCompile(`^\x1b\[(4[0-7]|10[0-7])m$`)},
+		{[]OptionFunc{WithANSIMode("unknown")}, regexp.MustCompile(`^\x1b\[38;2;(\d+);(\d+);(\d+)m$`)},
+	}
+
+	for _, tt := range sequencePatterns {
+		for i := 0; i < 100; i++ {
+			sequence := ANSI(tt.opts...)
+			matches := tt.pattern.FindStringSubmatch(sequence)
+			if matches == nil {
+				t.Fatalf("ANSI() = %q, want a match of %s", sequence, tt.pattern)
+			}
+			for _, match := range matches[1:] {
+				if value, _ := strconv.Atoi(match); value > 255 {
+					t.Errorf("ANSI() = %q, which has a value greater than 255", sequence)
+				}
+			}
+		}
+	}
+
+	// Range options pick the nearest color of the mode
+	for i := 0; i < 100; i++ {
+		if got := ANSI(WithANSIMode(ANSIMode16), WithHueRange(0, 10), WithSaturationRange(90, 100), WithLightnessRange(45, 55)); got != "\x1b[91m" && got != "\x1b[31m" {
+			t.Errorf("ANSI() of a red = %q, want a red terminal color", got)
+		}
+	}
+
+	steelBlue := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 1.0}
+	methodTests := []struct {
+		opts     []OptionFunc
+		expected string
+	}{
+		{nil, "\x1b[38;2;70;130;180m"},
+		{[]OptionFunc{WithANSIBackground(true)}, "\x1b[48;2;70;130;180m"},
+		{[]OptionFunc{WithANSIMode(ANSIMode256)}, "\x1b[38;5;67m"},
+		{[]OptionFunc{WithANSIMode(ANSIMode16), WithANSIBackground(true)}, "\x1b[100m"},
+	}
+
+	for _, tt := range methodTests {
+		if got := steelBlue.ANSI(tt.opts...); got != tt.expected {
+			t.Errorf("ANSI() of %v = %q, want %q", steelBlue, got, tt.expected)
+		}
+	}
+}
+
+// TestANSI256 tests the ANSI256 and ANSI16 methods of RGBAColor
+func TestANSI256(t *testing.T) {
+	indexTests := []struct {
+		input   RGBAColor
+		ansi256 int
+		ansi16  int
+	}{
+		{RGBAColor{Red: 0, Green: 0, Blue: 0, Alpha: 1.0}, 16, 0},
+		{RGBAColor{Red: 255, Green: 255, Blue: 255, Alpha: 1.0}, 231, 15},
+		{RGBAColor{Red: 255, Green: 0, Blue: 0, Alpha: 1.0}, 196, 9},
+		{RGBAColor{Red: 205, Green: 0, Blue: 0, Alpha: 1.0}, 160, 1},
+		{RGBAColor{Red: 0, Green: 0, Blue: 238, Alpha: 0.5}, 21, 4},
+		{RGBAColor{Red: 95, Green: 135, Blue: 175, Alpha: 1.0}, 67, 8},
+		{RGBAColor{Red: 128, Green: 128, Blue: 128, Alpha: 1.0}, 244, 8},
+		{RGBAColor{Red: 238, Green: 238, Blue: 238, Alpha: 1.0}, 255, 7},
+		{RGBAColor{Red: 18, Green: 18, Blue: 20, Alpha: 1.0}, 233, 0},
+	}
+
+	for _, tt := range indexTests {
+		if got := tt.input.ANSI256(); got != tt.ansi256 {
+			t.Errorf("ANSI256() of %v = %d, want %d", tt.input, got, tt.ansi256)
+		}
+		if got := tt.input.ANSI16(); got != tt.ansi16 {
+			t.Errorf("ANSI16() of %v = %d, want %d", tt.input, got, tt.ansi16)
+		}
+	}
+
+	// Every color of the cube and the gray ramp is its own nearest color
+	for index := 16; index < 256; index++ {
+		var c RGBAColor
+		if index < 232 {
+			cube := index - 16
+			c = RGBAColor{Red: ansiCubeLevels[cube/36], Green: ansiCubeLevels[cube/6%6], Blue: ansiCubeLevels[cube%6], Alpha: 1.0}
+		} else {
+			gray := 8 + 10*(index-232)
+			c = RGBAColor{Red: gray, Green: gray, Blue: gray, Alpha: 1.0}
+		}
+		if got := c.ANSI256(); got != index {
+			t.Errorf("ANSI256() of %v = %d, want %d", c, got, index)
+		}
+	}
+}
+
+// TestStyled tests the Styled function
+func TestStyled(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	pattern := regexp.MustCompile(`^\x1b\[(1;)?(4;)?38;5;\d+mhello\x1b\[0m$`)
+	bold, underline := 0, 0
+	for i := 0; i < 200; i++ {
+		styled := Styled("hello", WithANSIMode(ANSIMode256))
+		matches := pattern.FindStringSubmatch(styled)
+		if matches == nil {
+			t.Fatalf("Styled(hello) = %q, want a match of %s", styled, pattern)
+		}
+		if matches[1] != "" {
+			bold++
+		}
+		if matches[2] != "" {
+			underline++
+		}
+	}
+	if bold == 0 || bold == 200 || underline == 0 || underline == 200 {
+		t.Errorf("Styled() was bold %d and underlined %d times out of 200, want a mix", bold, underline)
+	}
+
+	if got := Styled("", WithANSIMode(ANSIMode16), WithANSIBackground(true)); !regexp.MustCompile(`^\x1b\[(1;)?(4;)?(4[0-7]|10[0-7])m\x1b\[0m$`).MatchString(got) {
+		t.Errorf("Styled() with a 16-color background = %q", got)
+	}
+}
+
 // BenchmarkRGBA benchmarks the RGBA function
 func BenchmarkRGBA(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -355,3 +1697,117 @@ func BenchmarkCMYKColorString(b *testing.B) {
 		_ = cmyk.String()
 	}
 }
+
+// BenchmarkRGBAColorToHSLA benchmarks the ToHSLA method of RGBAColor
+func BenchmarkRGBAColorToHSLA(b *testing.B) {
+	rgba := RGBA()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = rgba.ToHSLA()
+	}
+}
+
+// BenchmarkHex benchmarks the Hex function
+func BenchmarkHex(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Hex()
+	}
+}
+
+// BenchmarkParse benchmarks the Parse function
+func BenchmarkParse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = Parse("rgba(157, 191, 251, 0.87)")
+	}
+}
+
+// BenchmarkRGBAWithRanges benchmarks the RGBA function with range options
+func BenchmarkRGBAWithRanges(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		RGBA(WithPreset(PresetPastel), WithHueRange(330, 30))
+	}
+}
+
+// BenchmarkPalette benchmarks the Palette function
+func BenchmarkPalette(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Palette(5, SchemeTriadic)
+	}
+}
+
+// BenchmarkDistinctPalette benchmarks the Palette function with the distinct scheme
+func BenchmarkDistinctPalette(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Palette(12, SchemeDistinct)
+	}
+}
+
+// BenchmarkContrastPair benchmarks the ContrastPair function
+func BenchmarkContrastPair(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		ContrastPair(ContrastAA)
+	}
+}
+
+// BenchmarkNearestName benchmarks the NearestName function
+func BenchmarkNearestName(b *testing.B) {
+	c := RGBAColor{Red: 2, Green: 130, Blue: 125, Alpha: 1.0}
+	for i := 0; i < b.N; i++ {
+		NearestName(c)
+	}
+}
+
+// BenchmarkOKLCHColorToRGBA benchmarks the gamut-mapped conversion of an OKLCH color to RGBA
+func BenchmarkOKLCHColorToRGBA(b *testing.B) {
+	c := OKLCHColor{L: 0.7, C: 0.4, H: 150, Alpha: 1.0}
+	for i := 0; i < b.N; i++ {
+		c.ToRGBA()
+	}
+}
+
+// BenchmarkDeltaE2000 benchmarks the DeltaE2000 function
+func BenchmarkDeltaE2000(b *testing.B) {
+	x, y := LabColor{50, 2.5, 0, 1}, LabColor{73, 25, -18, 1}
+	for i := 0; i < b.N; i++ {
+		DeltaE2000(x, y)
+	}
+}
+
+// BenchmarkRGBAColorMarshalJSON benchmarks the MarshalJSON method of RGBAColor
+func BenchmarkRGBAColorMarshalJSON(b *testing.B) {
+	c := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 0.5}
+	for i := 0; i < b.N; i++ {
+		_, _ = c.MarshalJSON()
+	}
+}
+
+// BenchmarkSimulate benchmarks the Simulate method of RGBAColor
+func BenchmarkSimulate(b *testing.B) {
+	c := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 1.0}
+	for i := 0; i < b.N; i++ {
+		_ = c.Simulate(DeficiencyTritanopia)
+	}
+}
+
+// BenchmarkTheme benchmarks the Theme function
+func BenchmarkTheme(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = Theme()
+	}
+}
+
+// BenchmarkFromString benchmarks the FromString function
+func BenchmarkFromString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = FromString("alice@example.com")
+	}
+}
+
+// BenchmarkANSI256 benchmarks the ANSI256 method of RGBAColor
+func BenchmarkANSI256(b *testing.B) {
+	c := RGBAColor{Red: 70, Green: 130, Blue: 180, Alpha: 1.0}
+	for i := 0; i < b.N; i++ {
+		_ = c.ANSI256()
+	}
+}
@@ -0,0 +1,145 @@
+package color
+
+import (
+	"math"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+// Minimum contrast ratios of the Web Content Accessibility Guidelines (WCAG)
+const (
+	// ContrastAA is the minimum contrast ratio of level AA for normal text
+	ContrastAA = 4.5
+	// ContrastAALargeText is the minimum contrast ratio of level AA for large text
+	ContrastAALargeText = 3.0
+	// ContrastAAA is the minimum contrast ratio of level AAA for normal text
+	ContrastAAA = 7.0
+	// ContrastAAALargeText is the minimum contrast ratio of level AAA for large text
+	ContrastAAALargeText = 4.5
+)
+
+// lowContrastMargin is how far below the threshold the contrast ratio of LowContrastPair can be
+const lowContrastMargin = 0.25
+
+// Luminance returns the WCAG relative luminance of the color, from 0 (black) to 1 (white)
+// The alpha channel is ignored
+func (r RGBAColor) Luminance() float64 {
+	return 0.2126*linearChannel(r.Red) + 0.7152*linearChannel(r.Green) + 0.0722*linearChannel(r.Blue)
+}
+
+// ContrastRatio returns the WCAG contrast ratio between the color and another color, from 1 to 21
+// The ratio is symmetric, and the alpha channels are ignored
+func (r RGBAColor) ContrastRatio(other RGBAColor) float64 {
+	lighter, darker := r.Luminance(), other.Luminance()
+	if lighter < darker {
+		lighter, darker = darker, lighter
+	}
+	return (lighter + 0.05) / (darker + 0.05)
+}
+
+// ContrastPair generates a random foreground and background color pair whose contrast ratio
+// is at least minRatio (from 1 to 21), such as ContrastAA or ContrastAAA
+// The background is drawn like RGB with the provided options, and the foreground uses a random hue
+// and saturation within the ranges; the background is darkened or lightened if the ratio cannot be reached
+func ContrastPair(minRatio float64, opts ...OptionFunc) (foreground, background RGBAColor) {
+	minRatio = clampRatio(minRatio)
+	return contrastPair(minRatio, math.Inf(1), opts)
+}
+
+// LowContrastPair generates a random foreground and background color pair whose contrast ratio
+// is just below threshold, by at most 0.25, to exercise contrast validators
+// It uses the same options as ContrastPair, and the threshold should be greater than 1
+func LowContrastPair(threshold float64, opts ...OptionFunc) (foreground, background RGBAColor) {
+	threshold = clampRatio(threshold)
+	return contrastPair(math.Max(1, threshold-lowContrastMargin), threshold, opts)
+}
+
+// contrastPair generates a foreground and background color pair whose contrast ratio is in [minRatio, maxRatio)
+func contrastPair(minRatio, maxRatio float64, opts []OptionFunc) (foreground, background RGBAColor) {
+	o := applyOptions(opts)
+
+	background = RGB(opts...)
+	hsl := randomHSL(o)
+
+	candidates := contrastCandidates(background, hsl.Hue, hsl.Saturation, minRatio, maxRatio)
+	if len(candidates) == 0 {
+		// Find the background lightness closest to the drawn one for which the ratio can be reached
+		backgroundHSL := background.ToHSLA()
+		for delta := 1; delta <= 100 && len(candidates) == 0; delta++ {
+			for _, lightness := range []int{backgroundHSL.Lightness - delta, backgroundHSL.Lightness + delta} {
+				if lightness < 0 || lightness > 100 {
+					continue
+				}
+
+				adjusted := HSLAColor{Hue: backgroundHSL.Hue, Saturation: backgroundHSL.Saturation, Lightness: lightness, Alpha: 1.0}
+				candidates = contrastCandidates(adjusted.ToRGBA(), hsl.Hue, hsl.Saturation, minRatio, maxRatio)
+				if len(candidates) > 0 {
+					background = adjusted.ToRGBA()
+					break
+				}
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		// Only reachable for ratios that no pair of distinct colors has (e.g., a threshold of 1)
+		return background, background
+	}
+
+	return candidates[random.IntN(len(candidates))], background
+}
+
+// contrastCandidates returns the distinct colors with the given hue and saturation whose contrast ratio
+// with the background is in [minRatio, maxRatio), going through the lightnesses in steps of 0.1%
+func contrastCandidates(background RGBAColor, hue, saturation int, minRatio, maxRatio float64) []RGBAColor {
+	backgroundLuminance := background.Luminance()
+
+	// Skip the search if neither black nor white reaches the minimum ratio
+	if (backgroundLuminance+0.05)/0.05 < minRatio && 1.05/(backgroundLuminance+0.05) < minRatio {
+		return nil
+	}
+
+	var candidates []RGBAColor
+	previous := RGBAColor{Red: -1}
+	for step := 0; step <= 1000; step++ {
+		red, green, blue := hslToRGB(float64(hue), float64(saturation)/100, float64(step)/1000)
+		candidate := newRGBAColor(red, green, blue, 1.0)
+		if candidate == previous || candidate == background {
+			continue
+		}
+		previous = candidate
+
+		if ratio := candidate.ContrastRatio(background); ratio >= minRatio && ratio < maxRatio {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	return candidates
+}
+
+// clampRatio clamps a contrast ratio to the range [1, 21]
+func clampRatio(ratio float64) float64 {
+	if !(ratio >= 1) {
+		return 1
+	}
+	return math.Min(ratio, 21)
+}
+
+// linearChannels holds the linear light value of every sRGB channel value
+var linearChannels = func() [256]float64 {
+	var values [256]float64
+	for channel := range values {
+		value := float64(channel) / 255
+		if value <= 0.04045 {
+			values[channel] = value / 12.92
+		} else {
+			values[channel] = math.Pow((value+0.055)/1.055, 2.4)
+		}
+	}
+	return values
+}()
+
+// linearChannel converts an sRGB channel (0-255) to linear light in [0, 1]
+func linearChannel(channel int) float64 {
+	return linearChannels[clampChannel(channel)]
+}
@@ -0,0 +1,167 @@
+package color
+
+import (
+	"math"
+)
+
+// Conversions between color spaces use the standard formulas on unrounded floating-point
+// channels, and only the final channels are rounded to the nearest integer (halves away from zero):
+// 0-255 for RGB, whole degrees from 0 to 359 for the hue, and whole percents for saturation,
+// lightness and CMYK. Alpha is copied unchanged between RGBA and HSLA; CMYK has no alpha, so
+// converting to CMYK drops it and converting from CMYK gives full alpha (1.0).
+//
+// RGBA is the reference space: converting an RGBA color to HSLA or CMYK and back changes each
+// channel by at most 5 (HSLA) or 2 (CMYK), which is the precision of the whole-degree and
+// whole-percent channels. The other direction is not an identity, because several HSLA and CMYK
+// values describe the same color (e.g., any hue at 0% saturation, or any CMY at 100% key), and
+// conversions always return the canonical form: hue and saturation 0 for grays, and the largest
+// possible key for CMYK.
+
+// ToHSLA converts the RGBA color to the HSLA color space
+func (r RGBAColor) ToHSLA() HSLAColor {
+	h, s, l := rgbToHSL(float64(r.Red)/255, float64(r.Green)/255, float64(r.Blue)/255)
+	return newHSLAColor(h, s, l, r.Alpha)
+}
+
+// ToCMYK converts the RGBA color to the CMYK color space, dropping the alpha channel
+func (r RGBAColor) ToCMYK() CMYKColor {
+	c, m, y, k := rgbToCMYK(float64(r.Red)/255, float64(r.Green)/255, float64(r.Blue)/255)
+	return newCMYKColor(c, m, y, k)
+}
+
+// ToRGBA returns the RGBA color itself, so that every color type can be converted to RGBA
+func (r RGBAColor) ToRGBA() RGBAColor {
+	return r
+}
+
+// ToRGBA converts the HSLA color to the RGBA color space
+func (h HSLAColor) ToRGBA() RGBAColor {
+	red, green, blue := hslToRGB(float64(h.Hue), float64(h.Saturation)/100, float64(h.Lightness)/100)
+	return newRGBAColor(red, green, blue, h.Alpha)
+}
+
+// ToCMYK converts the HSLA color to the CMYK color space, dropping the alpha channel
+func (h HSLAColor) ToCMYK() CMYKColor {
+	red, green, blue := hslToRGB(float64(h.Hue), float64(h.Saturation)/100, float64(h.Lightness)/100)
+	c, m, y, k := rgbToCMYK(red, green, blue)
+	return newCMYKColor(c, m, y, k)
+}
+
+// ToHSLA returns the HSLA color itself, so that every color type can be converted to HSLA
+func (h HSLAColor) ToHSLA() HSLAColor {
+	return h
+}
+
+// ToRGBA converts the CMYK color to the RGBA color space with full alpha (1.0)
+func (c CMYKColor) ToRGBA() RGBAColor {
+	red, green, blue := cmykToRGB(float64(c.Cyan)/100, float64(c.Magenta)/100, float64(c.Yellow)/100, float64(c.Key)/100)
+	return newRGBAColor(red, green, blue, 1.0)
+}
+
+// ToHSLA converts the CMYK color to the HSLA color space with full alpha (1.0)
+func (c CMYKColor) ToHSLA() HSLAColor {
+	red, green, blue := cmykToRGB(float64(c.Cyan)/100, float64(c.Magenta)/100, float64(c.Yellow)/100, float64(c.Key)/100)
+	h, s, l := rgbToHSL(red, green, blue)
+	return newHSLAColor(h, s, l, 1.0)
+}
+
+// ToCMYK returns the CMYK color itself, so that every color type can be converted to CMYK
+func (c CMYKColor) ToCMYK() CMYKColor {
+	return c
+}
+
+// newRGBAColor builds an RGBA color from channels in [0, 1], rounding them to 0-255
+func newRGBAColor(red, green, blue, alpha float64) RGBAColor {
+	return RGBAColor{
+		Red:   roundChannel(red, 255),
+		Green: roundChannel(green, 255),
+		Blue:  roundChannel(blue, 255),
+		Alpha: alpha,
+	}
+}
+
+// newHSLAColor builds an HSLA color from a hue in degrees and saturation and lightness in [0, 1]
+func newHSLAColor(hue, saturation, lightness, alpha float64) HSLAColor {
+	return HSLAColor{
+		Hue:        int(math.Round(hue)) % 360,
+		Saturation: roundChannel(saturation, 100),
+		Lightness:  roundChannel(lightness, 100),
+		Alpha:      alpha,
+	}
+}
+
+// newCMYKColor builds a CMYK color from channels in [0, 1], rounding them to whole percents
+func newCMYKColor(cyan, magenta, yellow, key float64) CMYKColor {
+	return CMYKColor{
+		Cyan:    roundChannel(cyan, 100),
+		Magenta: roundChannel(magenta, 100),
+		Yellow:  roundChannel(yellow, 100),
+		Key:     roundChannel(key, 100),
+	}
+}
+
+// roundChannel scales a channel in [0, 1] to [0, scale] and rounds it to the nearest integer
+func roundChannel(value, scale float64) int {
+	return int(math.Round(math.Max(0, math.Min(1, value)) * scale))
+}
+
+// rgbToHSL converts RGB channels in [0, 1] to a hue in [0, 360) and saturation and lightness in [0, 1]
+func rgbToHSL(red, green, blue float64) (hue, saturation, lightness float64) {
+	maxChannel := math.Max(red, math.Max(green, blue))
+	minChannel := math.Min(red, math.Min(green, blue))
+	lightness = (maxChannel + minChannel) / 2
+
+	delta := maxChannel - minChannel
+	if delta == 0 {
+		return 0, 0, lightness
+	}
+
+	if lightness > 0.5 {
+		saturation = delta / (2 - maxChannel - minChannel)
+	} else {
+		saturation = delta / (maxChannel + minChannel)
+	}
+
+	switch maxChannel {
+	case red:
+		hue = (green - blue) / delta
+	case green:
+		hue = (blue-red)/delta + 2
+	default:
+		hue = (red-green)/delta + 4
+	}
+
+	hue = math.Mod(hue*60+360, 360)
+	return hue, saturation, lightness
+}
+
+// hslToRGB converts a hue in degrees and saturation and lightness in [0, 1] to RGB channels in [0, 1]
+func hslToRGB(hue, saturation, lightness float64) (red, green, blue float64) {
+	hue = math.Mod(math.Mod(hue, 360)+360, 360)
+	chroma := (1 - math.Abs(2*lightness-1)) * saturation
+
+	channel := func(n float64) float64 {
+		k := math.Mod(n+hue/30, 12)
+		return lightness - chroma/2*math.Max(-1, math.Min(k-3, math.Min(9-k, 1)))
+	}
+
+	return channel(0), channel(8), channel(4)
+}
+
+// rgbToCMYK converts RGB channels in [0, 1] to CMYK channels in [0, 1]
+func rgbToCMYK(red, green, blue float64) (cyan, magenta, yellow, key float64) {
+	key = 1 - math.Max(red, math.Max(green, blue))
+	if key == 1 {
+		return 0, 0, 0, 1
+	}
+
+	cyan = (1 - red - key) / (1 - key)
+	magenta = (1 - green - key) / (1 - key)
+	yellow = (1 - blue - key) / (1 - key)
+	return cyan, magenta, yellow, key
+}
+
+// cmykToRGB converts CMYK channels in [0, 1] to RGB channels in [0, 1]
+func cmykToRGB(cyan, magenta, yellow, key float64) (red, green, blue float64) {
+	return (1 - cyan) * (1 - key), (1 - magenta) * (1 - key), (1 - yellow) * (1 - key)
+}
@@ -0,0 +1,188 @@
+package color
+
+import (
+	"math"
+)
+
+// Simulation matrices in linear sRGB: protanopia and deuteranopia use the single-plane projections of
+// Viénot, Brettel and Mollon (1999), and tritanopia uses the two half-planes of Brettel, Viénot and Mollon (1997)
+var (
+	protanopiaMatrix = [3][3]float64{
+		{0.11238, 0.88762, 0.00000},
+		{0.11238, 0.88762, 0.00000},
+		{0.00401, -0.00401, 1.00000},
+	}
+	deuteranopiaMatrix = [3][3]float64{
+		{0.29275, 0.70725, 0.00000},
+		{0.29275, 0.70725, 0.00000},
+		{-0.02234, 0.02234, 1.00000},
+	}
+	// tritanopiaSeparation is the normal of the plane that separates the two tritanopia half-planes
+	tritanopiaSeparation = [3]float64{0.03901, -0.02788, -0.01113}
+	tritanopiaMatrix1    = [3][3]float64{
+		{1.01277, 0.13548, -0.14826},
+		{-0.01243, 0.86812, 0.14431},
+		{0.07589, 0.80500, 0.11911},
+	}
+	tritanopiaMatrix2 = [3][3]float64{
+		{0.93678, 0.18979, -0.12657},
+		{0.06154, 0.81526, 0.12320},
+		{-0.37562, 1.12767, 0.24796},
+	}
+)
+
+// allDeficiencies lists every color vision deficiency, in the order they are checked
+var allDeficiencies = []string{DeficiencyProtanopia, DeficiencyDeuteranopia, DeficiencyTritanopia, DeficiencyAchromatopsia}
+
+// safePaletteAttempts is the number of random base colors SafePalette tries before returning its longest palette
+const safePaletteAttempts = 5
+
+// Simulate returns the color as seen with a color vision deficiency:
+// DeficiencyProtanopia, DeficiencyDeuteranopia, DeficiencyTritanopia or DeficiencyAchromatopsia
+// The simulation models the complete loss of a cone type (or of all color perception for achromatopsia),
+// so it shows the largest possible effect; the alpha channel is kept, and unknown deficiencies return the color unchanged
+func (r RGBAColor) Simulate(deficiency string) RGBAColor {
+	linear := [3]float64{linearChannel(r.Red), linearChannel(r.Green), linearChannel(r.Blue)}
+
+	var simulated [3]float64
+	switch deficiency {
+	case DeficiencyProtanopia:
+		simulated = multiply(protanopiaMatrix, linear)
+	case DeficiencyDeuteranopia:
+		simulated = multiply(deuteranopiaMatrix, linear)
+	case DeficiencyTritanopia:
+		side := tritanopiaSeparation[0]*linear[0] + tritanopiaSeparation[1]*linear[1] + tritanopiaSeparation[2]*linear[2]
+		if side >= 0 {
+			simulated = multiply(tritanopiaMatrix1, linear)
+		} else {
+			simulated = multiply(tritanopiaMatrix2, linear)
+		}
+	case DeficiencyAchromatopsia:
+		luminance := r.Luminance()
+		simulated = [3]float64{luminance, luminance, luminance}
+	default:
+		return r
+	}
+
+	rgb := clip(xyzToRGB(multiply(linearRGBToXYZ, simulated)))
+	return newRGBAColor(rgb[0], rgb[1], rgb[2], r.Alpha)
+}
+
+// Distinguishable reports whether every pair of colors stays distinguishable with normal color vision
+// and with each color vision deficiency, which means a ΔE2000 difference of at least 10 between their simulations
+// WithDeficiencies selects the deficiencies to check (all of them by default) and WithMinDeltaE sets the difference
+func Distinguishable[T Color](colors []T, opts ...OptionFunc) bool {
+	o := applyOptions(opts)
+
+	labs := make([][][3]float64, len(colors))
+	for i, c := range colors {
+		labs[i] = simulatedLabs(c.ToRGBA(), o.deficiencies)
+	}
+
+	for i := range labs {
+		for j := i + 1; j < len(labs); j++ {
+			if visionDistance(labs[i], labs[j]) < o.minDeltaE {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// SafePalette generates up to n colors that stay distinguishable with normal color vision and with
+// each color vision deficiency, as checked by Distinguishable with the same options
+// The colors are picked like Palette with SchemeDistinct, from a random base color (or WithPaletteBase)
+// and within the range options, but the distances are measured between the simulated colors
+// Fewer than n colors are returned when no more colors can be told apart, which happens sooner when
+// achromatopsia is checked, since its colors can only differ in lightness
+func SafePalette(n int, opts ...OptionFunc) []HSLAColor {
+	if n <= 0 {
+		return []HSLAColor{}
+	}
+
+	o := applyOptions(opts)
+	candidates := distinctCandidates(o)
+	candidateLabs := make([][][3]float64, len(candidates))
+	for i, candidate := range candidates {
+		candidateLabs[i] = simulatedLabs(candidate.ToRGBA(), o.deficiencies)
+	}
+
+	// Without a base color, try several random bases and keep the longest palette
+	attempts := safePaletteAttempts
+	if o.paletteHasBase {
+		attempts = 1
+	}
+
+	var best []HSLAColor
+	for attempt := 0; attempt < attempts && len(best) < n; attempt++ {
+		base := o.paletteBase
+		if !o.paletteHasBase {
+			baseOption := o
+			baseOption.lightnessMin, baseOption.lightnessMax = paletteLightnessRange(o)
+			base = randomHSL(baseOption)
+		}
+
+		if palette := safePalette(n, base, candidates, candidateLabs, o); len(palette) > len(best) {
+			best = palette
+		}
+	}
+
+	return best
+}
+
+// safePalette greedily adds the candidate color whose simulations are the farthest from the nearest
+// picked color, and stops when n colors are picked or the farthest candidate is closer than the minimum difference
+func safePalette(n int, base HSLAColor, candidates []HSLAColor, candidateLabs [][][3]float64, o Option) []HSLAColor {
+	palette := make([]HSLAColor, 0, n)
+	palette = append(palette, base)
+
+	// distances holds the distance of each candidate to its nearest picked color
+	baseLabs := simulatedLabs(base.ToRGBA(), o.deficiencies)
+	distances := make([]float64, len(candidates))
+	for i := range candidates {
+		distances[i] = visionDistance(candidateLabs[i], baseLabs)
+	}
+
+	for len(palette) < n {
+		farthest := 0
+		for i := range candidates {
+			if distances[i] > distances[farthest] {
+				farthest = i
+			}
+		}
+		if len(candidates) == 0 || distances[farthest] < o.minDeltaE {
+			break
+		}
+
+		picked := candidates[farthest]
+		picked.Alpha = base.Alpha
+		palette = append(palette, picked)
+
+		for i := range candidates {
+			distances[i] = math.Min(distances[i], visionDistance(candidateLabs[i], candidateLabs[farthest]))
+		}
+	}
+
+	return palette
+}
+
+// simulatedLabs returns the CIELAB coordinates of a color with normal color vision,
+// followed by those of its simulation for each deficiency
+func simulatedLabs(c RGBAColor, deficiencies []string) [][3]float64 {
+	labs := make([][3]float64, 0, len(deficiencies)+1)
+	labs = append(labs, labCoordinates(c))
+	for _, deficiency := range deficiencies {
+		labs = append(labs, labCoordinates(c.Simulate(deficiency)))
+	}
+	return labs
+}
+
+// visionDistance returns the smallest ΔE2000 difference between two colors over all simulated color visions
+func visionDistance(a, b [][3]float64) float64 {
+	distance := math.Inf(1)
+	for i := range a {
+		distance = math.Min(distance, deltaE2000(a[i], b[i]))
+	}
+	return distance
+}
@@ -0,0 +1,90 @@
+package color
+
+import (
+	"math"
+)
+
+// DeltaE2000 returns the CIEDE2000 perceptual difference between two colors of any color space
+// A difference of about 1 is the smallest one that is noticeable, and identical colors have a difference of 0
+// The alpha channels are ignored
+func DeltaE2000(a, b Color) float64 {
+	return deltaE2000(labCoordinates(a), labCoordinates(b))
+}
+
+// labCoordinates returns the CIELAB coordinates of a color, without rounding if it can be converted to XYZ
+func labCoordinates(c Color) [3]float64 {
+	return xyzToLab(asXYZ(c).vector())
+}
+
+// deltaE2000 computes the CIEDE2000 difference between two CIELAB colors
+// with the formulas of Sharma, Wu and Dalal (2005) and unit weighting factors
+func deltaE2000(lab1, lab2 [3]float64) float64 {
+	l1, a1, b1 := lab1[0], lab1[1], lab1[2]
+	l2, a2, b2 := lab2[0], lab2[1], lab2[2]
+
+	// pow7Ratio returns sqrt(c^7 / (c^7 + 25^7))
+	pow7Ratio := func(c float64) float64 {
+		c7 := math.Pow(c, 7)
+		return math.Sqrt(c7 / (c7 + math.Pow(25, 7)))
+	}
+	degrees := func(radians float64) float64 {
+		return radians * 180 / math.Pi
+	}
+	radians := func(degrees float64) float64 {
+		return degrees * math.Pi / 180
+	}
+	hueAngle := func(a, b float64) float64 {
+		if a == 0 && b == 0 {
+			return 0
+		}
+		return math.Mod(degrees(math.Atan2(b, a))+360, 360)
+	}
+
+	// Adjust the a axis to make neutral colors more uniform
+	g := 0.5 * (1 - pow7Ratio((math.Hypot(a1, b1)+math.Hypot(a2, b2))/2))
+	a1Prime, a2Prime := (1+g)*a1, (1+g)*a2
+	c1Prime, c2Prime := math.Hypot(a1Prime, b1), math.Hypot(a2Prime, b2)
+	h1Prime, h2Prime := hueAngle(a1Prime, b1), hueAngle(a2Prime, b2)
+
+	// Differences of lightness, chroma and hue
+	deltaL := l2 - l1
+	deltaC := c2Prime - c1Prime
+	deltaHueAngle := 0.0
+	if c1Prime*c2Prime != 0 {
+		deltaHueAngle = h2Prime - h1Prime
+		if deltaHueAngle > 180 {
+			deltaHueAngle -= 360
+		} else if deltaHueAngle < -180 {
+			deltaHueAngle += 360
+		}
+	}
+	deltaH := 2 * math.Sqrt(c1Prime*c2Prime) * math.Sin(radians(deltaHueAngle/2))
+
+	// Means of lightness, chroma and hue
+	meanL := (l1 + l2) / 2
+	meanC := (c1Prime + c2Prime) / 2
+	meanH := h1Prime + h2Prime
+	if c1Prime*c2Prime != 0 {
+		switch {
+		case math.Abs(h1Prime-h2Prime) <= 180:
+			meanH /= 2
+		case meanH < 360:
+			meanH = (meanH + 360) / 2
+		default:
+			meanH = (meanH - 360) / 2
+		}
+	}
+
+	// Weighting functions and the rotation term of the blue region
+	t := 1 - 0.17*math.Cos(radians(meanH-30)) + 0.24*math.Cos(radians(2*meanH)) +
+		0.32*math.Cos(radians(3*meanH+6)) - 0.20*math.Cos(radians(4*meanH-63))
+	deltaTheta := 30 * math.Exp(-math.Pow((meanH-275)/25, 2))
+	rotation := -math.Sin(radians(2*deltaTheta)) * 2 * pow7Ratio(meanC)
+
+	weightL := 1 + 0.015*math.Pow(meanL-50, 2)/math.Sqrt(20+math.Pow(meanL-50, 2))
+	weightC := 1 + 0.045*meanC
+	weightH := 1 + 0.015*meanC*t
+
+	termL, termC, termH := deltaL/weightL, deltaC/weightC, deltaH/weightH
+	return math.Sqrt(termL*termL + termC*termC + termH*termH + rotation*termC*termH)
+}
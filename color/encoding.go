@@ -0,0 +1,465 @@
+package color
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	imagecolor "image/color"
+	"math"
+	"strconv"
+)
+
+// Every color type implements image/color.Color, so that it can be used with the image packages,
+// and can be stored as text: encoding.TextMarshaler and encoding.TextUnmarshaler, json.Marshaler,
+// and database/sql Scanner and driver.Valuer. The text form of a color is its String form with the full
+// precision of the alpha and float channels (e.g., rgba(70, 130, 180, 0.125)), so that decoding it gives back
+// the same color. Unmarshalling and scanning accept any form supported by Parse, and convert colors of
+// another type (e.g., a hex code scanned into an HSLAColor).
+
+// FromColor converts an image/color.Color to an RGBAColor
+// Colors of this package are converted with ToRGBA, and other colors are un-premultiplied
+// and their channels scaled to 0-255, with the alpha channel scaled to 0-1
+func FromColor(c imagecolor.Color) RGBAColor {
+	if own, ok := c.(Color); ok {
+		return own.ToRGBA()
+	}
+
+	nrgba := imagecolor.NRGBA64Model.Convert(c).(imagecolor.NRGBA64)
+	return RGBAColor{
+		Red:   int(math.Round(float64(nrgba.R) / 0x101)),
+		Green: int(math.Round(float64(nrgba.G) / 0x101)),
+		Blue:  int(math.Round(float64(nrgba.B) / 0x101)),
+		Alpha: float64(nrgba.A) / 0xffff,
+	}
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels in the range 0-0xffff
+func (r RGBAColor) RGBA() (red, green, blue, alpha uint32) {
+	alpha = uint32(math.Round(math.Max(0, math.Min(1, r.Alpha)) * 0xffff))
+	red = uint32(clampChannel(r.Red)) * 0x101 * alpha / 0xffff
+	green = uint32(clampChannel(r.Green)) * 0x101 * alpha / 0xffff
+	blue = uint32(clampChannel(r.Blue)) * 0x101 * alpha / 0xffff
+	return red, green, blue, alpha
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (h HSLAColor) RGBA() (red, green, blue, alpha uint32) {
+	return h.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (c CMYKColor) RGBA() (red, green, blue, alpha uint32) {
+	return c.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (h HSVAColor) RGBA() (red, green, blue, alpha uint32) {
+	return h.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (h HWBAColor) RGBA() (red, green, blue, alpha uint32) {
+	return h.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (x XYZColor) RGBA() (red, green, blue, alpha uint32) {
+	return x.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (l LabColor) RGBA() (red, green, blue, alpha uint32) {
+	return l.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (l LCHColor) RGBA() (red, green, blue, alpha uint32) {
+	return l.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (l OKLabColor) RGBA() (red, green, blue, alpha uint32) {
+	return l.ToRGBA().RGBA()
+}
+
+// RGBA implements image/color.Color, returning the alpha-premultiplied channels of the color converted to RGBA
+func (l OKLCHColor) RGBA() (red, green, blue, alpha uint32) {
+	return l.ToRGBA().RGBA()
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (r RGBAColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(r)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (r *RGBAColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, r)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (r RGBAColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(r))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (r RGBAColor) Value() (driver.Value, error) {
+	return encodeColor(r), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (r *RGBAColor) Scan(src any) error {
+	return scanColor(src, r)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (h HSLAColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(h)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (h *HSLAColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, h)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (h HSLAColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(h))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (h HSLAColor) Value() (driver.Value, error) {
+	return encodeColor(h), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (h *HSLAColor) Scan(src any) error {
+	return scanColor(src, h)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (c CMYKColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(c)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (c *CMYKColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, c)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (c CMYKColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(c))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (c CMYKColor) Value() (driver.Value, error) {
+	return encodeColor(c), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (c *CMYKColor) Scan(src any) error {
+	return scanColor(src, c)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (h HSVAColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(h)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (h *HSVAColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, h)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (h HSVAColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(h))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (h HSVAColor) Value() (driver.Value, error) {
+	return encodeColor(h), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (h *HSVAColor) Scan(src any) error {
+	return scanColor(src, h)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (h HWBAColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(h)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (h *HWBAColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, h)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (h HWBAColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(h))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (h HWBAColor) Value() (driver.Value, error) {
+	return encodeColor(h), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (h *HWBAColor) Scan(src any) error {
+	return scanColor(src, h)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (x XYZColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(x)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (x *XYZColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, x)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (x XYZColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(x))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (x XYZColor) Value() (driver.Value, error) {
+	return encodeColor(x), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (x *XYZColor) Scan(src any) error {
+	return scanColor(src, x)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (l LabColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(l)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (l *LabColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, l)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (l LabColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(l))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (l LabColor) Value() (driver.Value, error) {
+	return encodeColor(l), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (l *LabColor) Scan(src any) error {
+	return scanColor(src, l)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (l LCHColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(l)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (l *LCHColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, l)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (l LCHColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(l))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (l LCHColor) Value() (driver.Value, error) {
+	return encodeColor(l), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (l *LCHColor) Scan(src any) error {
+	return scanColor(src, l)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (l OKLabColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(l)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (l *OKLabColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, l)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (l OKLabColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(l))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (l OKLabColor) Value() (driver.Value, error) {
+	return encodeColor(l), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (l *OKLabColor) Scan(src any) error {
+	return scanColor(src, l)
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the text form of the color
+func (l OKLCHColor) MarshalText() ([]byte, error) {
+	return []byte(encodeColor(l)), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing any color string supported by Parse
+func (l *OKLCHColor) UnmarshalText(text []byte) error {
+	return unmarshalColor(text, l)
+}
+
+// MarshalJSON implements json.Marshaler, returning the text form of the color as a JSON string
+func (l OKLCHColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(encodeColor(l))
+}
+
+// Value implements driver.Valuer, storing the text form of the color
+func (l OKLCHColor) Value() (driver.Value, error) {
+	return encodeColor(l), nil
+}
+
+// Scan implements sql.Scanner, parsing a string or bytes column with Parse
+// A NULL column sets the zero value
+func (l *OKLCHColor) Scan(src any) error {
+	return scanColor(src, l)
+}
+
+// encodeColor returns the text form of a color: its String form with the full precision of the alpha and float channels
+func encodeColor(c Color) string {
+	switch c := c.(type) {
+	case RGBAColor:
+		if c.Alpha == 1.0 {
+			return c.String()
+		}
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", c.Red, c.Green, c.Blue, exactNumber(c.Alpha))
+	case HSLAColor:
+		if c.Alpha == 1.0 {
+			return c.String()
+		}
+		return fmt.Sprintf("hsla(%d°, %d%%, %d%%, %s)", c.Hue, c.Saturation, c.Lightness, exactNumber(c.Alpha))
+	case HSVAColor:
+		if c.Alpha == 1.0 {
+			return c.String()
+		}
+		return fmt.Sprintf("hsva(%d°, %d%%, %d%%, %s)", c.Hue, c.Saturation, c.Brightness, exactNumber(c.Alpha))
+	case HWBAColor:
+		return fmt.Sprintf("hwb(%d %d%% %d%%%s)", c.Hue, c.Whiteness, c.Blackness, exactAlpha(c.Alpha))
+	case XYZColor:
+		return fmt.Sprintf("color(xyz-d65 %s %s %s%s)", exactNumber(c.X), exactNumber(c.Y), exactNumber(c.Z), exactAlpha(c.Alpha))
+	case LabColor:
+		return fmt.Sprintf("lab(%s%% %s %s%s)", exactNumber(c.L), exactNumber(c.A), exactNumber(c.B), exactAlpha(c.Alpha))
+	case LCHColor:
+		return fmt.Sprintf("lch(%s%% %s %s%s)", exactNumber(c.L), exactNumber(c.C), exactNumber(c.H), exactAlpha(c.Alpha))
+	case OKLabColor:
+		return fmt.Sprintf("oklab(%s %s %s%s)", exactNumber(c.L), exactNumber(c.A), exactNumber(c.B), exactAlpha(c.Alpha))
+	case OKLCHColor:
+		return fmt.Sprintf("oklch(%s %s %s%s)", exactNumber(c.L), exactNumber(c.C), exactNumber(c.H), exactAlpha(c.Alpha))
+	default:
+		// CMYK colors have integer channels and no alpha, so their String form is already exact
+		return c.String()
+	}
+}
+
+// exactNumber formats a number with the fewest digits that parse back to the same value
+func exactNumber(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// exactAlpha returns the " / alpha" suffix of CSS color functions like formatAlpha, without rounding the alpha
+func exactAlpha(alpha float64) string {
+	if alpha == 1.0 {
+		return ""
+	}
+	return " / " + exactNumber(alpha)
+}
+
+// unmarshalColor parses a color string into a color of type T, converting colors of other types
+func unmarshalColor[T Color](text []byte, target *T) error {
+	c, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*target = convertColor[T](c)
+	return nil
+}
+
+// scanColor scans a database value into a color of type T
+func scanColor[T Color](src any, target *T) error {
+	switch value := src.(type) {
+	case nil:
+		var zero T
+		*target = zero
+		return nil
+	case string:
+		return unmarshalColor([]byte(value), target)
+	case []byte:
+		return unmarshalColor(value, target)
+	default:
+		return fmt.Errorf("%w: cannot scan %T into a color", ErrInvalidColor, src)
+	}
+}
+
+// convertColor converts a color to the color type T, keeping colors that already have that type
+// The CIE color types are converted from XYZ without rounding when the color can be converted to XYZ
+func convertColor[T Color](c Color) T {
+	if value, ok := c.(T); ok {
+		return value
+	}
+
+	var converted Color
+	switch any(*new(T)).(type) {
+	case RGBAColor:
+		converted = c.ToRGBA()
+	case HSLAColor:
+		converted = c.ToHSLA()
+	case CMYKColor:
+		converted = c.ToCMYK()
+	case HSVAColor:
+		converted = c.ToRGBA().ToHSVA()
+	case HWBAColor:
+		converted = c.ToRGBA().ToHWBA()
+	case XYZColor:
+		converted = asXYZ(c)
+	case LabColor:
+		converted = asXYZ(c).ToLab()
+	case LCHColor:
+		converted = asXYZ(c).ToLCH()
+	case OKLabColor:
+		converted = asXYZ(c).ToOKLab()
+	case OKLCHColor:
+		converted = asXYZ(c).ToOKLCH()
+	}
+	return converted.(T)
+}
+
+// asXYZ converts a color to an XYZ color, without rounding if the color can be converted to XYZ
+func asXYZ(c Color) XYZColor {
+	if converter, ok := c.(interface{ ToXYZ() XYZColor }); ok {
+		return converter.ToXYZ()
+	}
+	return c.ToRGBA().ToXYZ()
+}
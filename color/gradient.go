@@ -0,0 +1,118 @@
+package color
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+// Gradient types
+const (
+	// GradientLinear is a gradient along a straight line, drawn with the CSS linear-gradient() function
+	GradientLinear = "linear"
+	// GradientRadial is a gradient radiating from the center, drawn with the CSS radial-gradient() function
+	GradientRadial = "radial"
+)
+
+// GradientStop represents a color of a gradient at a position from 0 (start) to 1 (end)
+type GradientStop struct {
+	Color    RGBAColor
+	Position float64
+}
+
+// Gradient represents a linear or radial gradient
+// The angle in degrees (0 points up and 90 to the right) is only used by linear gradients
+type Gradient struct {
+	Type  string
+	Angle int
+	Stops []GradientStop
+}
+
+// gradientToken is a stop of a gradient in the W3C Design Tokens format
+type gradientToken struct {
+	Color    string  `json:"color"`
+	Position float64 `json:"position"`
+}
+
+// LinearGradient generates a random linear gradient with n evenly spaced color stops (at least 2)
+// The colors are drawn like RGB with the range options, with a random alpha only with WithAlphaRange,
+// and the angle is random unless WithGradientAngle is used
+func LinearGradient(n int, opts ...OptionFunc) Gradient {
+	o := applyOptions(opts)
+
+	angle := o.gradientAngle
+	if !o.gradientHasAngle {
+		angle = random.IntN(360)
+	}
+
+	return Gradient{
+		Type:  GradientLinear,
+		Angle: angle,
+		Stops: gradientStops(n, opts),
+	}
+}
+
+// RadialGradient generates a random circular gradient with n evenly spaced color stops (at least 2),
+// from the center outwards, drawn like the stops of LinearGradient
+func RadialGradient(n int, opts ...OptionFunc) Gradient {
+	return Gradient{
+		Type:  GradientRadial,
+		Stops: gradientStops(n, opts),
+	}
+}
+
+// gradientStops generates n random color stops evenly spaced from 0 to 1, with at least 2 stops
+func gradientStops(n int, opts []OptionFunc) []GradientStop {
+	n = max(n, 2)
+
+	stops := make([]GradientStop, n)
+	for i := range stops {
+		stops[i] = GradientStop{
+			Color:    randomSpaceColor(opts),
+			Position: float64(i) / float64(n-1),
+		}
+	}
+	return stops
+}
+
+// String returns the CSS function of the gradient
+// (e.g., "linear-gradient(90deg, #ff8800 0%, #336699 100%)" or "radial-gradient(circle, #ff8800 0%, #336699 100%)")
+func (g Gradient) String() string {
+	var result strings.Builder
+	if g.Type == GradientRadial {
+		result.WriteString("radial-gradient(circle")
+	} else {
+		result.WriteString("linear-gradient(" + formatNumber(float64(g.Angle), 0) + "deg")
+	}
+
+	for _, stop := range g.Stops {
+		result.WriteString(", " + tokenHex(stop.Color) + " " + formatNumber(stop.Position*100, 2) + "%")
+	}
+	result.WriteByte(')')
+
+	return result.String()
+}
+
+// DesignTokens returns the gradient as an indented W3C Design Tokens JSON gradient token,
+// with the colors as hex codes and the positions from 0 to 1
+// The token has no angle or shape, which the format does not describe
+func (g Gradient) DesignTokens() ([]byte, error) {
+	stops := make([]gradientToken, len(g.Stops))
+	for i, stop := range g.Stops {
+		stops[i] = gradientToken{Color: tokenHex(stop.Color), Position: stop.Position}
+	}
+
+	return json.MarshalIndent(struct {
+		Type  string          `json:"$type"`
+		Value []gradientToken `json:"$value"`
+	}{"gradient", stops}, "", "  ")
+}
+
+// tokenHex returns the 6 digit hex code of a color, or the 8 digit hex code if it is not opaque
+func tokenHex(c RGBAColor) string {
+	if c.Alpha < 1 {
+		return c.Hex(WithHexDigits(8))
+	}
+	return c.Hex()
+}
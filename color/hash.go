@@ -0,0 +1,82 @@
+package color
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+)
+
+// FromString maps a string to a color through a stable hash, so that the same string always gets the same
+// color (e.g., for avatars and tags), independently of the random source and across library versions
+// It does not parse the string; use Parse for color strings
+// The color is drawn like RGB with the range options, and has an alpha from the hash only with WithAlphaRange
+func FromString(s string, opts ...OptionFunc) RGBAColor {
+	return FromBytes([]byte(s), opts...)
+}
+
+// FromBytes maps bytes to a color through a stable hash, like FromString
+func FromBytes(data []byte, opts ...OptionFunc) RGBAColor {
+	o := applyOptions(opts)
+	intN := hashSource(data)
+
+	var c RGBAColor
+	if o.hasColorRange() {
+		c = hashHSL(o, intN).ToRGBA()
+	} else {
+		c = RGBAColor{Red: intN(256), Green: intN(256), Blue: intN(256), Alpha: 1.0}
+	}
+
+	if o.hasAlphaRange() {
+		c.Alpha = hashAlpha(o, intN)
+	}
+	return c
+}
+
+// hashHSL draws an HSL color with full alpha within the hue, saturation and lightness ranges from a hash source
+// It is frozen, independently of how random colors are drawn (see sourceHSL), so that the colors of
+// FromString and FromBytes never change
+func hashHSL(o Option, intN intSource) HSLAColor {
+	var hue int
+	if o.hueMin <= o.hueMax {
+		hue = (o.hueMin + intN(o.hueMax-o.hueMin+1)) % 360
+	} else {
+		hue = (o.hueMin + intN(360-o.hueMin+o.hueMax+1)) % 360
+	}
+	saturation := o.saturationMin + intN(o.saturationMax-o.saturationMin+1)
+	lightness := o.lightnessMin + intN(o.lightnessMax-o.lightnessMin+1)
+
+	if o.grayscale {
+		hue, saturation = 0, 0
+	}
+
+	return HSLAColor{Hue: hue, Saturation: saturation, Lightness: lightness, Alpha: 1.0}
+}
+
+// hashAlpha draws an alpha value in steps of 0.01 within the alpha range from a hash source, frozen like hashHSL
+func hashAlpha(o Option, intN intSource) float64 {
+	minAlpha := int(math.Ceil(o.alphaMin*100 - 1e-9))
+	maxAlpha := int(math.Floor(o.alphaMax*100 + 1e-9))
+	if minAlpha > maxAlpha {
+		return o.alphaMin
+	}
+
+	return float64(minAlpha+intN(maxAlpha-minAlpha+1)) / 100.0
+}
+
+// hashSource returns a source that draws integers from the SHA-256 hash of data, 8 bytes at a time
+// When the bytes of the hash are used up, the hash is hashed again
+func hashSource(data []byte) intSource {
+	sum := sha256.Sum256(data)
+	next := 0
+
+	return func(n int) int {
+		if next+8 > len(sum) {
+			sum = sha256.Sum256(sum[:])
+			next = 0
+		}
+
+		value := binary.BigEndian.Uint64(sum[next:])
+		next += 8
+		return int(value % uint64(n))
+	}
+}
@@ -0,0 +1,80 @@
+package color
+
+import (
+	"fmt"
+	"github.com/khchehab/muzayaf/random"
+	"math"
+	"strings"
+)
+
+// Hex generates a random hex color code based on the provided options
+// By default it returns a lowercase 6 digit code with a leading '#' (e.g., #1a2b3c)
+// With hue, saturation, lightness or grayscale options the color is drawn like RGBA and then formatted
+func Hex(opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	if o.hasColorRange() {
+		return RGBA(opts...).Hex(opts...)
+	}
+
+	// Shorthand codes use one digit per channel, which is doubled to get the full value
+	channelMax := 256
+	if o.hexDigits == 3 || o.hexDigits == 4 {
+		channelMax = 16
+	}
+
+	channels := []int{random.IntN(channelMax), random.IntN(channelMax), random.IntN(channelMax)}
+	if o.hexDigits == 4 || o.hexDigits == 8 {
+		channels = append(channels, random.IntN(channelMax))
+	}
+
+	return formatHex(channels, o)
+}
+
+// Hex returns the hex color code of the RGBA color based on the provided options
+// The 3 and 4 digit forms round each channel to the nearest shorthand value, and the alpha
+// channel is scaled from 0-1 to 0-255 for the 4 and 8 digit forms
+func (r RGBAColor) Hex(opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	channels := []int{clampChannel(r.Red), clampChannel(r.Green), clampChannel(r.Blue)}
+	if o.hexDigits == 4 || o.hexDigits == 8 {
+		channels = append(channels, int(math.Round(math.Max(0, math.Min(1, r.Alpha))*255)))
+	}
+
+	if o.hexDigits == 3 || o.hexDigits == 4 {
+		for i, channel := range channels {
+			channels[i] = int(math.Round(float64(channel) / 17))
+		}
+	}
+
+	return formatHex(channels, o)
+}
+
+// formatHex formats channels as a hex color code
+// Shorthand codes expect channels from 0 to 15, and full codes channels from 0 to 255
+func formatHex(channels []int, o Option) string {
+	var result strings.Builder
+	if o.hexPrefix {
+		result.WriteByte('#')
+	}
+
+	layout := "%02x"
+	if o.hexDigits == 3 || o.hexDigits == 4 {
+		layout = "%x"
+	}
+	for _, channel := range channels {
+		fmt.Fprintf(&result, layout, channel)
+	}
+
+	if o.hexUppercase {
+		return strings.ToUpper(result.String())
+	}
+
+	return result.String()
+}
+
+// clampChannel clamps an RGB channel to the range 0-255
+func clampChannel(channel int) int {
+	return max(0, min(255, channel))
+}
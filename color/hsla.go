@@ -2,7 +2,6 @@ package color
 
 import (
 	"fmt"
-	"github.com/khchehab/muzayaf/random"
 )
 
 // HSLAColor represents a color in the HSLA color space
@@ -23,22 +22,17 @@ func (h HSLAColor) String() string {
 		h.Hue, h.Saturation, h.Lightness, h.Alpha)
 }
 
-// HSLA generates a random HSLA color
-func HSLA() HSLAColor {
-	return HSLAColor{
-		Hue:        random.IntN(361),                  // 0-360 degrees
-		Saturation: random.IntN(101),                  // 0-100 percent
-		Lightness:  random.IntN(101),                  // 0-100 percent
-		Alpha:      float64(random.IntN(101)) / 100.0, // 0-1 inclusive
-	}
+// HSLA generates a random HSLA color based on the provided options
+func HSLA(opts ...OptionFunc) HSLAColor {
+	o := applyOptions(opts)
+
+	hsla := randomHSL(o)
+	hsla.Alpha = randomAlpha(o)
+	return hsla
 }
 
-// HSL generates a random HSL color
-func HSL() HSLAColor {
-	return HSLAColor{
-		Hue:        random.IntN(361), // 0-360 degrees
-		Saturation: random.IntN(101), // 0-100 percent
-		Lightness:  random.IntN(101), // 0-100 percent
-		Alpha:      1.0,
-	}
+// HSL generates a random HSL color with full alpha (1.0) based on the provided options
+// The alpha range option is ignored
+func HSL(opts ...OptionFunc) HSLAColor {
+	return randomHSL(applyOptions(opts))
 }
@@ -0,0 +1,78 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// HSVAColor represents a color in the HSVA color space, also known as HSB
+// The value channel (V) is named Brightness, the B of HSB
+type HSVAColor struct {
+	Hue        int
+	Saturation int
+	Brightness int
+	Alpha      float64
+}
+
+// String returns a string representation of the HSV color
+// CSS has no HSV function, so the format follows the one of HSL colors
+func (h HSVAColor) String() string {
+	if h.Alpha == 1.0 {
+		return fmt.Sprintf("hsv(%d°, %d%%, %d%%)",
+			h.Hue, h.Saturation, h.Brightness)
+	}
+	return fmt.Sprintf("hsva(%d°, %d%%, %d%%, %.2f)",
+		h.Hue, h.Saturation, h.Brightness, h.Alpha)
+}
+
+// HSV generates a random HSV color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, and has a random alpha only with WithAlphaRange
+func HSV(opts ...OptionFunc) HSVAColor {
+	return randomSpaceColor(opts).ToHSVA()
+}
+
+// ToHSVA converts the RGBA color to the HSVA color space
+func (r RGBAColor) ToHSVA() HSVAColor {
+	rgb := rgbChannels(r)
+	hue, _, _ := rgbToHSL(rgb[0], rgb[1], rgb[2])
+
+	value := math.Max(rgb[0], math.Max(rgb[1], rgb[2]))
+	saturation := 0.0
+	if value > 0 {
+		saturation = (value - math.Min(rgb[0], math.Min(rgb[1], rgb[2]))) / value
+	}
+
+	return HSVAColor{
+		Hue:        int(math.Round(hue)) % 360,
+		Saturation: roundChannel(saturation, 100),
+		Brightness: roundChannel(value, 100),
+		Alpha:      r.Alpha,
+	}
+}
+
+// ToRGBA converts the HSVA color to the RGBA color space
+// Because of the whole-degree and whole-percent channels, converting an RGBA color to HSVA and back
+// changes each channel by at most 3
+func (h HSVAColor) ToRGBA() RGBAColor {
+	saturation, value := float64(h.Saturation)/100, float64(h.Brightness)/100
+
+	// Convert to HSL, which shares the hue of HSV
+	lightness := value * (1 - saturation/2)
+	hslSaturation := 0.0
+	if lightness > 0 && lightness < 1 {
+		hslSaturation = (value - lightness) / math.Min(lightness, 1-lightness)
+	}
+
+	red, green, blue := hslToRGB(float64(h.Hue), hslSaturation, lightness)
+	return newRGBAColor(red, green, blue, h.Alpha)
+}
+
+// ToHSLA converts the HSVA color to the HSLA color space
+func (h HSVAColor) ToHSLA() HSLAColor {
+	return h.ToRGBA().ToHSLA()
+}
+
+// ToCMYK converts the HSVA color to the CMYK color space, dropping the alpha channel
+func (h HSVAColor) ToCMYK() CMYKColor {
+	return h.ToRGBA().ToCMYK()
+}
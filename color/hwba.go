@@ -0,0 +1,63 @@
+package color
+
+import (
+	"fmt"
+	"math"
+)
+
+// HWBAColor represents a color in the HWB (hue, whiteness, blackness) color space with an alpha channel
+type HWBAColor struct {
+	Hue       int
+	Whiteness int
+	Blackness int
+	Alpha     float64
+}
+
+// String returns the CSS representation of the HWB color (e.g., hwb(210 20% 40%))
+func (h HWBAColor) String() string {
+	return fmt.Sprintf("hwb(%d %d%% %d%%%s)", h.Hue, h.Whiteness, h.Blackness, formatAlpha(h.Alpha))
+}
+
+// HWB generates a random HWB color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, and has a random alpha only with WithAlphaRange
+func HWB(opts ...OptionFunc) HWBAColor {
+	return randomSpaceColor(opts).ToHWBA()
+}
+
+// ToHWBA converts the RGBA color to the HWB color space
+func (r RGBAColor) ToHWBA() HWBAColor {
+	rgb := rgbChannels(r)
+	hue, _, _ := rgbToHSL(rgb[0], rgb[1], rgb[2])
+
+	return HWBAColor{
+		Hue:       int(math.Round(hue)) % 360,
+		Whiteness: roundChannel(math.Min(rgb[0], math.Min(rgb[1], rgb[2])), 100),
+		Blackness: roundChannel(1-math.Max(rgb[0], math.Max(rgb[1], rgb[2])), 100),
+		Alpha:     r.Alpha,
+	}
+}
+
+// ToRGBA converts the HWB color to the RGBA color space
+// Because of the whole-degree and whole-percent channels, converting an RGBA color to HWB and back
+// changes each channel by at most 3; like in CSS, a whiteness and blackness adding up to more than 100% are scaled down to a gray
+func (h HWBAColor) ToRGBA() RGBAColor {
+	whiteness, blackness := float64(h.Whiteness)/100, float64(h.Blackness)/100
+	if whiteness+blackness >= 1 {
+		gray := whiteness / (whiteness + blackness)
+		return newRGBAColor(gray, gray, gray, h.Alpha)
+	}
+
+	red, green, blue := hslToRGB(float64(h.Hue), 1, 0.5)
+	scale := 1 - whiteness - blackness
+	return newRGBAColor(red*scale+whiteness, green*scale+whiteness, blue*scale+whiteness, h.Alpha)
+}
+
+// ToHSLA converts the HWB color to the HSLA color space
+func (h HWBAColor) ToHSLA() HSLAColor {
+	return h.ToRGBA().ToHSLA()
+}
+
+// ToCMYK converts the HWB color to the CMYK color space, dropping the alpha channel
+func (h HWBAColor) ToCMYK() CMYKColor {
+	return h.ToRGBA().ToCMYK()
+}
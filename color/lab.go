@@ -0,0 +1,114 @@
+package color
+
+import (
+	"fmt"
+)
+
+// LabColor represents a color in the CIELAB color space with the D50 white point and an alpha channel
+// L is the lightness from 0 to 100, and A and B are the green-red and blue-yellow axes
+type LabColor struct {
+	L     float64
+	A     float64
+	B     float64
+	Alpha float64
+}
+
+// LCHColor represents a color in the LCH color space, the polar form of CIELAB, with an alpha channel
+// L is the lightness from 0 to 100, C the chroma and H the hue in degrees
+type LCHColor struct {
+	L     float64
+	C     float64
+	H     float64
+	Alpha float64
+}
+
+// String returns the CSS representation of the Lab color (e.g., lab(52.23% 40.16 59.99))
+func (l LabColor) String() string {
+	return fmt.Sprintf("lab(%s%% %s %s%s)",
+		formatNumber(l.L, 2), formatNumber(l.A, 2), formatNumber(l.B, 2), formatAlpha(l.Alpha))
+}
+
+// String returns the CSS representation of the LCH color (e.g., lch(52.23% 72.2 56.2))
+func (l LCHColor) String() string {
+	return fmt.Sprintf("lch(%s%% %s %s%s)",
+		formatNumber(l.L, 2), formatNumber(l.C, 2), formatNumber(l.H, 2), formatAlpha(l.Alpha))
+}
+
+// Lab generates a random Lab color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, so it is always in the sRGB gamut, and has a random alpha only with WithAlphaRange
+func Lab(opts ...OptionFunc) LabColor {
+	return randomSpaceColor(opts).ToLab()
+}
+
+// LCH generates a random LCH color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, so it is always in the sRGB gamut, and has a random alpha only with WithAlphaRange
+func LCH(opts ...OptionFunc) LCHColor {
+	return randomSpaceColor(opts).ToLCH()
+}
+
+// ToLab converts the RGBA color to the CIELAB color space
+func (r RGBAColor) ToLab() LabColor {
+	return r.ToXYZ().ToLab()
+}
+
+// ToLCH converts the RGBA color to the LCH color space
+func (r RGBAColor) ToLCH() LCHColor {
+	return r.ToXYZ().ToLCH()
+}
+
+// ToLCH converts the Lab color to the LCH color space
+func (l LabColor) ToLCH() LCHColor {
+	chroma, hue := toPolar(l.A, l.B)
+	return LCHColor{L: l.L, C: chroma, H: hue, Alpha: l.Alpha}
+}
+
+// ToLab converts the LCH color to the CIELAB color space
+func (l LCHColor) ToLab() LabColor {
+	a, b := fromPolar(l.C, l.H)
+	return LabColor{L: l.L, A: a, B: b, Alpha: l.Alpha}
+}
+
+// ToXYZ converts the Lab color to the XYZ color space
+func (l LabColor) ToXYZ() XYZColor {
+	return newXYZColor(labToXYZ([3]float64{l.L, l.A, l.B}), l.Alpha)
+}
+
+// ToXYZ converts the LCH color to the XYZ color space
+func (l LCHColor) ToXYZ() XYZColor {
+	return l.ToLab().ToXYZ()
+}
+
+// ToRGBA converts the Lab color to the RGBA color space, gamut mapping colors outside of sRGB
+func (l LabColor) ToRGBA() RGBAColor {
+	return l.ToXYZ().ToRGBA()
+}
+
+// ToRGBA converts the LCH color to the RGBA color space, gamut mapping colors outside of sRGB
+func (l LCHColor) ToRGBA() RGBAColor {
+	return l.ToXYZ().ToRGBA()
+}
+
+// ToHSLA converts the Lab color to the HSLA color space, gamut mapping colors outside of sRGB
+func (l LabColor) ToHSLA() HSLAColor {
+	return l.ToRGBA().ToHSLA()
+}
+
+// ToHSLA converts the LCH color to the HSLA color space, gamut mapping colors outside of sRGB
+func (l LCHColor) ToHSLA() HSLAColor {
+	return l.ToRGBA().ToHSLA()
+}
+
+// ToCMYK converts the Lab color to the CMYK color space, gamut mapping colors outside of sRGB
+func (l LabColor) ToCMYK() CMYKColor {
+	return l.ToRGBA().ToCMYK()
+}
+
+// ToCMYK converts the LCH color to the CMYK color space, gamut mapping colors outside of sRGB
+func (l LCHColor) ToCMYK() CMYKColor {
+	return l.ToRGBA().ToCMYK()
+}
+
+// newLabColor builds a Lab color from its L, a and b coordinates
+func newLabColor(lab [3]float64, alpha float64) LabColor {
+	return LabColor{L: lab[0], A: lab[1], B: lab[2], Alpha: alpha}
+}
@@ -1,13 +1,43 @@
 package color
 
 import (
+	"maps"
+	"math"
+	"slices"
+	"sync"
+
 	"github.com/khchehab/muzayaf/internal"
 	"github.com/khchehab/muzayaf/random"
 )
 
+var (
+	// fallbackNamedColor is the value of the fallback color name of every locale
+	fallbackNamedColor = RGBAColor{Red: 255, Green: 255, Blue: 255, Alpha: 1.0}
+	// namedColorsCache stores the named colors of each locale to avoid parsing them on every call
+	namedColorsCache = make(map[string][]namedColor)
+	// namedColorsCacheSync provides thread-safe access to the namedColorsCache
+	namedColorsCacheSync sync.RWMutex
+)
+
+// namedColor is a CSS named color with its localized name
+type namedColor struct {
+	keyword string
+	name    string
+	value   RGBAColor
+	lab     [3]float64
+}
+
 // ColorName generates a random color name
 // It can use a specific locale if specified in the options (default is "en")
 func ColorName(opts ...OptionFunc) string {
+	name, _ := Named(opts...)
+	return name
+}
+
+// Named generates a random named color and returns its name and value
+// The colors are the CSS Color Module Level 4 named colors, and the names are the CSS keywords
+// (e.g., "darkseagreen") unless the locale in the options has localized names
+func Named(opts ...OptionFunc) (string, RGBAColor) {
 	o := applyOptions(opts)
 
 	// Validate locale
@@ -16,15 +46,83 @@ func ColorName(opts ...OptionFunc) string {
 		o.locale = "en"
 	}
 
-	data, err := internal.LoadJsonFile("color", o.locale, "names.json")
-	if err != nil {
-		return fallbackValues[o.locale]["name"]
+	colors := loadNamedColors(o.locale)
+	if len(colors) == 0 {
+		return fallbackValues[o.locale]["name"], fallbackNamedColor
 	}
 
-	pool := internal.GetStringSlice(data, "colors")
-	if len(pool) == 0 {
+	named := colors[random.IntN(len(colors))]
+	return named.name, named.value
+}
+
+// NearestName returns the name of the named color closest to a color in the OKLab perceptual color space
+// The alpha channel is ignored, and colors with several names (e.g., "aqua" and "cyan") use the first
+// CSS keyword in alphabetical order; names are localized like Named
+func NearestName(c Color, opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	colors := loadNamedColors(o.locale)
+	if len(colors) == 0 {
 		return fallbackValues[o.locale]["name"]
 	}
 
-	return pool[random.IntN(len(pool))]
+	target := toOKLab(c.ToRGBA())
+	nearest, nearestDistance := 0, math.Inf(1)
+	for i, named := range colors {
+		if distance := labDistance(target, named.lab); distance < nearestDistance {
+			nearest, nearestDistance = i, distance
+		}
+	}
+
+	return colors[nearest].name
+}
+
+// loadNamedColors loads the named colors of the base locale sorted by CSS keyword,
+// with the localized names of the locale layered over the keywords
+func loadNamedColors(locale string) []namedColor {
+	namedColorsCacheSync.RLock()
+	if colors, ok := namedColorsCache[locale]; ok {
+		namedColorsCacheSync.RUnlock()
+		return colors
+	}
+	namedColorsCacheSync.RUnlock()
+
+	data, err := internal.LoadJsonFile("color", "base", "names.json")
+	if err != nil {
+		return nil
+	}
+	values := internal.GetStringMap(data, "colors")
+
+	// Names missing from the locale, or all names if the locale has no names file, use the CSS keyword
+	names := map[string]string{}
+	if localeData, err := internal.LoadJsonFile("color", locale, "names.json"); err == nil {
+		names = internal.GetStringMap(localeData, "names")
+	}
+
+	colors := make([]namedColor, 0, len(values))
+	for _, keyword := range slices.Sorted(maps.Keys(values)) {
+		value, err := parseHex(values[keyword], values[keyword])
+		if err != nil {
+			continue
+		}
+
+		name := keyword
+		if localized := names[keyword]; localized != "" {
+			name = localized
+		}
+
+		colors = append(colors, namedColor{keyword: keyword, name: name, value: value.ToRGBA(), lab: toOKLab(value.ToRGBA())})
+	}
+
+	namedColorsCacheSync.Lock()
+	namedColorsCache[locale] = colors
+	namedColorsCacheSync.Unlock()
+
+	return colors
 }
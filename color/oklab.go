@@ -0,0 +1,114 @@
+package color
+
+import (
+	"fmt"
+)
+
+// OKLabColor represents a color in the OKLab perceptual color space with an alpha channel
+// L is the lightness from 0 to 1, and A and B are the green-red and blue-yellow axes
+type OKLabColor struct {
+	L     float64
+	A     float64
+	B     float64
+	Alpha float64
+}
+
+// OKLCHColor represents a color in the OKLCH color space, the polar form of OKLab, with an alpha channel
+// L is the lightness from 0 to 1, C the chroma and H the hue in degrees
+type OKLCHColor struct {
+	L     float64
+	C     float64
+	H     float64
+	Alpha float64
+}
+
+// String returns the CSS representation of the OKLab color (e.g., oklab(0.6253 0.0914 -0.0437))
+func (l OKLabColor) String() string {
+	return fmt.Sprintf("oklab(%s %s %s%s)",
+		formatNumber(l.L, 4), formatNumber(l.A, 4), formatNumber(l.B, 4), formatAlpha(l.Alpha))
+}
+
+// String returns the CSS representation of the OKLCH color (e.g., oklch(0.6253 0.1013 334.42))
+func (l OKLCHColor) String() string {
+	return fmt.Sprintf("oklch(%s %s %s%s)",
+		formatNumber(l.L, 4), formatNumber(l.C, 4), formatNumber(l.H, 2), formatAlpha(l.Alpha))
+}
+
+// OKLab generates a random OKLab color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, so it is always in the sRGB gamut, and has a random alpha only with WithAlphaRange
+func OKLab(opts ...OptionFunc) OKLabColor {
+	return randomSpaceColor(opts).ToOKLab()
+}
+
+// OKLCH generates a random OKLCH color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, so it is always in the sRGB gamut, and has a random alpha only with WithAlphaRange
+func OKLCH(opts ...OptionFunc) OKLCHColor {
+	return randomSpaceColor(opts).ToOKLCH()
+}
+
+// ToOKLab converts the RGBA color to the OKLab color space
+func (r RGBAColor) ToOKLab() OKLabColor {
+	return r.ToXYZ().ToOKLab()
+}
+
+// ToOKLCH converts the RGBA color to the OKLCH color space
+func (r RGBAColor) ToOKLCH() OKLCHColor {
+	return r.ToXYZ().ToOKLCH()
+}
+
+// ToOKLCH converts the OKLab color to the OKLCH color space
+func (l OKLabColor) ToOKLCH() OKLCHColor {
+	chroma, hue := toPolar(l.A, l.B)
+	return OKLCHColor{L: l.L, C: chroma, H: hue, Alpha: l.Alpha}
+}
+
+// ToOKLab converts the OKLCH color to the OKLab color space
+func (l OKLCHColor) ToOKLab() OKLabColor {
+	a, b := fromPolar(l.C, l.H)
+	return OKLabColor{L: l.L, A: a, B: b, Alpha: l.Alpha}
+}
+
+// ToXYZ converts the OKLab color to the XYZ color space
+func (l OKLabColor) ToXYZ() XYZColor {
+	return newXYZColor(okLabToXYZ([3]float64{l.L, l.A, l.B}), l.Alpha)
+}
+
+// ToXYZ converts the OKLCH color to the XYZ color space
+func (l OKLCHColor) ToXYZ() XYZColor {
+	return l.ToOKLab().ToXYZ()
+}
+
+// ToRGBA converts the OKLab color to the RGBA color space, gamut mapping colors outside of sRGB
+func (l OKLabColor) ToRGBA() RGBAColor {
+	return l.ToXYZ().ToRGBA()
+}
+
+// ToRGBA converts the OKLCH color to the RGBA color space, gamut mapping colors outside of sRGB
+func (l OKLCHColor) ToRGBA() RGBAColor {
+	return l.ToXYZ().ToRGBA()
+}
+
+// ToHSLA converts the OKLab color to the HSLA color space, gamut mapping colors outside of sRGB
+func (l OKLabColor) ToHSLA() HSLAColor {
+	return l.ToRGBA().ToHSLA()
+}
+
+// ToHSLA converts the OKLCH color to the HSLA color space, gamut mapping colors outside of sRGB
+func (l OKLCHColor) ToHSLA() HSLAColor {
+	return l.ToRGBA().ToHSLA()
+}
+
+// ToCMYK converts the OKLab color to the CMYK color space, gamut mapping colors outside of sRGB
+func (l OKLabColor) ToCMYK() CMYKColor {
+	return l.ToRGBA().ToCMYK()
+}
+
+// ToCMYK converts the OKLCH color to the CMYK color space, gamut mapping colors outside of sRGB
+func (l OKLCHColor) ToCMYK() CMYKColor {
+	return l.ToRGBA().ToCMYK()
+}
+
+// newOKLabColor builds an OKLab color from its L, a and b coordinates
+func newOKLabColor(lab [3]float64, alpha float64) OKLabColor {
+	return OKLabColor{L: lab[0], A: lab[1], B: lab[2], Alpha: alpha}
+}
@@ -1,8 +1,97 @@
 package color
 
+import (
+	"math"
+	"slices"
+)
+
+// Preset names for WithPreset
+const (
+	// PresetPastel generates soft, light colors with moderate saturation
+	PresetPastel = "pastel"
+	// PresetVivid generates bright, fully saturated colors
+	PresetVivid = "vivid"
+	// PresetDark generates dark colors
+	PresetDark = "dark"
+	// PresetLight generates light colors
+	PresetLight = "light"
+)
+
+// Palette schemes for Palette
+const (
+	// SchemeComplementary pairs the base hue with the opposite hue
+	SchemeComplementary = "complementary"
+	// SchemeSplitComplementary pairs the base hue with the two hues next to its complement
+	SchemeSplitComplementary = "split-complementary"
+	// SchemeTriadic uses three hues evenly spaced around the color wheel
+	SchemeTriadic = "triadic"
+	// SchemeTetradic uses four hues evenly spaced around the color wheel
+	SchemeTetradic = "tetradic"
+	// SchemeAnalogous uses hues next to the base hue
+	SchemeAnalogous = "analogous"
+	// SchemeMonochromatic uses the base hue with different lightnesses
+	SchemeMonochromatic = "monochromatic"
+	// SchemeDistinct spaces the colors as far apart from each other as possible in a perceptual color space
+	SchemeDistinct = "distinct"
+)
+
+// ANSI color modes for WithANSIMode
+const (
+	// ANSIMode16 uses the 16 standard and bright terminal colors
+	ANSIMode16 = "16"
+	// ANSIMode256 uses the 256 colors of xterm-compatible terminals
+	ANSIMode256 = "256"
+	// ANSIModeTrueColor uses 24-bit RGB colors
+	ANSIModeTrueColor = "truecolor"
+)
+
+// Color vision deficiencies for Simulate and WithDeficiencies
+const (
+	// DeficiencyProtanopia is the absence of the red-sensitive (long wavelength) cones
+	DeficiencyProtanopia = "protanopia"
+	// DeficiencyDeuteranopia is the absence of the green-sensitive (medium wavelength) cones
+	DeficiencyDeuteranopia = "deuteranopia"
+	// DeficiencyTritanopia is the absence of the blue-sensitive (short wavelength) cones
+	DeficiencyTritanopia = "tritanopia"
+	// DeficiencyAchromatopsia is the absence of color perception, where only lightness is seen
+	DeficiencyAchromatopsia = "achromatopsia"
+)
+
 // Option struct holds configuration for color data generation
 type Option struct {
 	locale string
+
+	// Range options
+	hueMin        int
+	hueMax        int
+	saturationMin int
+	saturationMax int
+	lightnessMin  int
+	lightnessMax  int
+	alphaMin      float64
+	alphaMax      float64
+	grayscale     bool
+
+	// Palette options
+	paletteBase    HSLAColor
+	paletteHasBase bool
+
+	// Gradient options
+	gradientAngle    int
+	gradientHasAngle bool
+
+	// Color vision deficiency options
+	deficiencies []string
+	minDeltaE    float64
+
+	// ANSI options
+	ansiMode       string
+	ansiBackground bool
+
+	// Hex options
+	hexDigits    int
+	hexUppercase bool
+	hexPrefix    bool
 }
 
 // OptionFunc is a function that modifies an Option
@@ -12,6 +101,30 @@ type OptionFunc func(*Option)
 func defaultOption() Option {
 	return Option{
 		locale: "en",
+
+		// Range defaults
+		hueMin:        0,
+		hueMax:        360,
+		saturationMin: 0,
+		saturationMax: 100,
+		lightnessMin:  0,
+		lightnessMax:  100,
+		alphaMin:      0.0,
+		alphaMax:      1.0,
+		grayscale:     false,
+
+		// Color vision deficiency defaults
+		deficiencies: allDeficiencies,
+		minDeltaE:    10,
+
+		// ANSI defaults
+		ansiMode:       ANSIModeTrueColor,
+		ansiBackground: false,
+
+		// Hex defaults
+		hexDigits:    6,
+		hexUppercase: false,
+		hexPrefix:    true,
 	}
 }
 
@@ -30,3 +143,173 @@ func WithLocale(locale string) OptionFunc {
 		o.locale = locale
 	}
 }
+
+// WithHueRange sets the range of hues in degrees (0-360) for generated colors
+// If min is greater than max the range wraps around 360 (e.g., 330 to 30 for reds)
+func WithHueRange(min, max int) OptionFunc {
+	return func(o *Option) {
+		o.hueMin = clampInt(min, 0, 360)
+		o.hueMax = clampInt(max, 0, 360)
+	}
+}
+
+// WithSaturationRange sets the range of saturations in percent (0-100) for generated colors
+func WithSaturationRange(min, max int) OptionFunc {
+	return func(o *Option) {
+		o.saturationMin, o.saturationMax = clampRange(min, max, 100)
+	}
+}
+
+// WithLightnessRange sets the range of lightnesses in percent (0-100) for generated colors
+func WithLightnessRange(min, max int) OptionFunc {
+	return func(o *Option) {
+		o.lightnessMin, o.lightnessMax = clampRange(min, max, 100)
+	}
+}
+
+// WithAlphaRange sets the range of alpha values (0-1) for generated colors with an alpha channel
+// Alpha values are generated in steps of 0.01, like the unconstrained colors
+func WithAlphaRange(min, max float64) OptionFunc {
+	return func(o *Option) {
+		if math.IsNaN(min) || math.IsNaN(max) {
+			return
+		}
+		if min > max {
+			min, max = max, min
+		}
+		o.alphaMin = math.Max(0, math.Min(1, min))
+		o.alphaMax = math.Max(0, math.Min(1, max))
+	}
+}
+
+// WithGrayscale sets whether generated colors are shades of gray (0% saturation)
+// It takes precedence over the hue and saturation ranges
+func WithGrayscale(grayscale bool) OptionFunc {
+	return func(o *Option) {
+		o.grayscale = grayscale
+	}
+}
+
+// WithPreset sets the saturation and lightness ranges to one of the presets:
+// PresetPastel, PresetVivid, PresetDark or PresetLight
+// Unknown presets are ignored, and range options applied after the preset override it
+func WithPreset(preset string) OptionFunc {
+	return func(o *Option) {
+		switch preset {
+		case PresetPastel:
+			o.saturationMin, o.saturationMax = 40, 70
+			o.lightnessMin, o.lightnessMax = 80, 90
+		case PresetVivid:
+			o.saturationMin, o.saturationMax = 80, 100
+			o.lightnessMin, o.lightnessMax = 40, 60
+		case PresetDark:
+			o.saturationMin, o.saturationMax = 30, 100
+			o.lightnessMin, o.lightnessMax = 10, 30
+		case PresetLight:
+			o.saturationMin, o.saturationMax = 20, 100
+			o.lightnessMin, o.lightnessMax = 65, 85
+		}
+	}
+}
+
+// WithPaletteBase sets the base color of palettes instead of a random one
+func WithPaletteBase(base HSLAColor) OptionFunc {
+	return func(o *Option) {
+		o.paletteBase = base
+		o.paletteHasBase = true
+	}
+}
+
+// WithGradientAngle sets the angle in degrees of linear gradients instead of a random one
+// The angle is normalized to the range 0-359 (e.g., -90 is 270)
+func WithGradientAngle(angle int) OptionFunc {
+	return func(o *Option) {
+		o.gradientAngle = positiveMod(angle, 360)
+		o.gradientHasAngle = true
+	}
+}
+
+// WithDeficiencies sets the color vision deficiencies checked by Distinguishable and SafePalette
+// Unknown deficiencies are ignored, and no deficiencies only checks normal color vision
+func WithDeficiencies(deficiencies ...string) OptionFunc {
+	return func(o *Option) {
+		o.deficiencies = []string{}
+		for _, deficiency := range deficiencies {
+			if slices.Contains(allDeficiencies, deficiency) && !slices.Contains(o.deficiencies, deficiency) {
+				o.deficiencies = append(o.deficiencies, deficiency)
+			}
+		}
+	}
+}
+
+// WithMinDeltaE sets the ΔE2000 difference from which Distinguishable and SafePalette consider two colors
+// distinguishable (10 by default); negative and NaN values are ignored
+func WithMinDeltaE(min float64) OptionFunc {
+	return func(o *Option) {
+		if min >= 0 {
+			o.minDeltaE = min
+		}
+	}
+}
+
+// WithANSIMode sets the color mode of ANSI escape sequences: ANSIMode16, ANSIMode256 or ANSIModeTrueColor
+// Unknown modes are ignored
+func WithANSIMode(mode string) OptionFunc {
+	return func(o *Option) {
+		switch mode {
+		case ANSIMode16, ANSIMode256, ANSIModeTrueColor:
+			o.ansiMode = mode
+		}
+	}
+}
+
+// WithANSIBackground sets whether ANSI escape sequences set the background color instead of the foreground color
+func WithANSIBackground(background bool) OptionFunc {
+	return func(o *Option) {
+		o.ansiBackground = background
+	}
+}
+
+// WithHexDigits sets the number of digits of hex color codes (3, 4, 6 or 8)
+// The 4 and 8 digit forms include the alpha channel
+func WithHexDigits(digits int) OptionFunc {
+	return func(o *Option) {
+		switch digits {
+		case 3, 4, 6, 8:
+			o.hexDigits = digits
+		}
+	}
+}
+
+// WithHexUppercase sets whether hex color codes use uppercase digits
+func WithHexUppercase(uppercase bool) OptionFunc {
+	return func(o *Option) {
+		o.hexUppercase = uppercase
+	}
+}
+
+// WithHexPrefix sets whether hex color codes start with a leading '#'
+func WithHexPrefix(include bool) OptionFunc {
+	return func(o *Option) {
+		o.hexPrefix = include
+	}
+}
+
+// clampInt clamps a value to the range [min, max]
+func clampInt(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
+
+// clampRange clamps a range to [0, limit], swapping its bounds if min is greater than max
+func clampRange(min, max, limit int) (int, int) {
+	if min > max {
+		min, max = max, min
+	}
+	return clampInt(min, 0, limit), clampInt(max, 0, limit)
+}
@@ -0,0 +1,190 @@
+package color
+
+import (
+	"math"
+)
+
+// schemeHueOffsets holds the hue offsets in degrees from the base hue of each palette scheme
+var schemeHueOffsets = map[string][]int{
+	SchemeComplementary:      {0, 180},
+	SchemeSplitComplementary: {0, 150, 210},
+	SchemeTriadic:            {0, 120, 240},
+	SchemeTetradic:           {0, 90, 180, 270},
+	SchemeAnalogous:          {0, 30, -30, 60, -60},
+	SchemeMonochromatic:      {0},
+}
+
+// Palette generates n harmonious colors following a scheme, starting with the base color
+// The base color is random (drawn with the range options, like HSL, but with a lightness from 10% to 90%)
+// unless WithPaletteBase is used
+// When n is larger than the number of hues of the scheme, the hues are repeated with different lightnesses
+// SchemeDistinct picks colors that are as far apart as possible in the OKLab perceptual color space,
+// within the hue, saturation and lightness ranges; the palette stops short of n colors when no
+// candidate color differs from the picked ones
+// Unknown schemes return an empty palette
+func Palette(n int, scheme string, opts ...OptionFunc) []HSLAColor {
+	offsets, exists := schemeHueOffsets[scheme]
+	if n <= 0 || (!exists && scheme != SchemeDistinct) {
+		return []HSLAColor{}
+	}
+
+	o := applyOptions(opts)
+
+	// A random base avoids near-black and near-white colors, which have no visible hue
+	base := o.paletteBase
+	if !o.paletteHasBase {
+		baseOption := o
+		baseOption.lightnessMin, baseOption.lightnessMax = paletteLightnessRange(o)
+		base = randomHSL(baseOption)
+	}
+
+	if scheme == SchemeDistinct {
+		return distinctPalette(n, base, o)
+	}
+
+	// The lightness of repeated hues moves away from the base lightness in alternating directions,
+	// wrapping around within the lightness range, in steps small enough to keep every color distinct
+	lo, hi := paletteLightnessRange(o)
+	rounds := (n + len(offsets) - 1) / len(offsets)
+	step := max(1, min(15, (hi-lo)/rounds))
+
+	palette := make([]HSLAColor, n)
+	for i := range palette {
+		round := i / len(offsets)
+		delta := (round + 1) / 2 * step
+		if round%2 == 0 {
+			delta = -delta
+		}
+
+		lightness := base.Lightness
+		if round > 0 {
+			lightness = lo + positiveMod(base.Lightness-lo+delta, hi-lo+1)
+		}
+
+		palette[i] = HSLAColor{
+			Hue:        positiveMod(base.Hue+offsets[i%len(offsets)], 360),
+			Saturation: base.Saturation,
+			Lightness:  lightness,
+			Alpha:      base.Alpha,
+		}
+	}
+
+	return palette
+}
+
+// paletteLightnessRange returns the lightness range of generated palette colors
+// It avoids near-black and near-white colors unless the lightness range requires them
+func paletteLightnessRange(o Option) (int, int) {
+	lo, hi := max(o.lightnessMin, 10), min(o.lightnessMax, 90)
+	if lo > hi {
+		return o.lightnessMin, o.lightnessMax
+	}
+	return lo, hi
+}
+
+// distinctPalette picks n colors that are as far apart as possible from each other and the base color
+// It greedily adds the candidate color whose nearest already picked color is the farthest away,
+// and stops before n colors once every candidate has already been picked (or equals the base color)
+func distinctPalette(n int, base HSLAColor, o Option) []HSLAColor {
+	candidates := distinctCandidates(o)
+
+	palette := make([]HSLAColor, 0, n)
+	palette = append(palette, base)
+
+	// distances holds the distance of each candidate to its nearest picked color
+	distances := make([]float64, len(candidates))
+	baseLab := toOKLab(base.ToRGBA())
+	candidateLabs := make([][3]float64, len(candidates))
+	for i, candidate := range candidates {
+		candidateLabs[i] = toOKLab(candidate.ToRGBA())
+		distances[i] = labDistance(candidateLabs[i], baseLab)
+	}
+
+	for len(palette) < n {
+		farthest := 0
+		for i := range candidates {
+			if distances[i] > distances[farthest] {
+				farthest = i
+			}
+		}
+		if distances[farthest] == 0 {
+			break
+		}
+
+		picked := candidates[farthest]
+		picked.Alpha = base.Alpha
+		palette = append(palette, picked)
+
+		for i := range candidates {
+			distances[i] = math.Min(distances[i], labDistance(candidateLabs[i], candidateLabs[farthest]))
+		}
+	}
+
+	return palette
+}
+
+// distinctCandidates returns the candidate colors of distinct palettes: hues in steps of 5 degrees
+// within the hue range, combined with 4 saturations and 5 lightnesses within the saturation and
+// lightness ranges, avoiding washed-out colors unless the saturation range requires them
+func distinctCandidates(o Option) []HSLAColor {
+	var hues []int
+	if o.hueMin <= o.hueMax {
+		for hue := o.hueMin; hue <= o.hueMax && hue < 360; hue += 5 {
+			hues = append(hues, hue)
+		}
+	} else {
+		for hue := o.hueMin; hue <= o.hueMax+360; hue += 5 {
+			hues = append(hues, hue%360)
+		}
+	}
+	if len(hues) == 0 {
+		hues = []int{o.hueMin % 360}
+	}
+
+	saturationMin, saturationMax := max(o.saturationMin, 40), o.saturationMax
+	if saturationMin > saturationMax {
+		saturationMin = o.saturationMin
+	}
+	saturations := spreadInts(saturationMin, saturationMax, 4)
+	if o.grayscale {
+		hues, saturations = []int{0}, []int{0}
+	}
+
+	lightnessMin, lightnessMax := max(o.lightnessMin, 25), min(o.lightnessMax, 80)
+	if lightnessMin > lightnessMax {
+		lightnessMin, lightnessMax = o.lightnessMin, o.lightnessMax
+	}
+	lightnesses := spreadInts(lightnessMin, lightnessMax, 5)
+
+	candidates := make([]HSLAColor, 0, len(hues)*len(saturations)*len(lightnesses))
+	for _, hue := range hues {
+		for _, saturation := range saturations {
+			for _, lightness := range lightnesses {
+				candidates = append(candidates, HSLAColor{Hue: hue, Saturation: saturation, Lightness: lightness, Alpha: 1.0})
+			}
+		}
+	}
+
+	return candidates
+}
+
+// spreadInts returns up to count integers evenly spread from min to max, both included
+func spreadInts(min, max, count int) []int {
+	if min == max || count < 2 {
+		return []int{min}
+	}
+
+	values := make([]int, 0, count)
+	for i := 0; i < count; i++ {
+		value := min + int(math.Round(float64(i*(max-min))/float64(count-1)))
+		if len(values) == 0 || values[len(values)-1] != value {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// positiveMod returns the remainder of a divided by b, in the range [0, b)
+func positiveMod(a, b int) int {
+	return (a%b + b) % b
+}
@@ -0,0 +1,338 @@
+package color
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidColor is returned when a string is not a valid color
+var ErrInvalidColor = errors.New("invalid color")
+
+// Color is implemented by all the color types of this package
+type Color interface {
+	String() string
+	ToRGBA() RGBAColor
+	ToHSLA() HSLAColor
+	ToCMYK() CMYKColor
+}
+
+// Parse parses a color string and returns the matching typed color
+// It accepts CSS named color keywords (e.g., "teal"), hex codes with 3, 4, 6 or 8 digits (with or without
+// a leading '#') and the forms produced by the String methods of every color type: rgb(), rgba(), hsl(),
+// hsla(), hsv(), hsva(), hwb(), cmyk(), color(xyz-d65 ...), lab(), lch(), oklab() and oklch()
+// Function arguments can be separated by commas or by spaces with an optional "/ alpha" (e.g., rgb(0 128 128 / 0.5))
+// Keywords, hex codes and rgb()/rgba() return an RGBAColor, and the other functions their own color type
+func Parse(s string) (Color, error) {
+	str := strings.ToLower(strings.TrimSpace(s))
+
+	name, args, isFunction := strings.Cut(str, "(")
+	if !isFunction {
+		for _, named := range loadNamedColors("en") {
+			if named.keyword == str {
+				return named.value, nil
+			}
+		}
+		return parseHex(s, str)
+	}
+
+	args, closed := strings.CutSuffix(args, ")")
+	if !closed {
+		return nil, fmt.Errorf("%w: missing closing parenthesis in %q", ErrInvalidColor, s)
+	}
+
+	values := splitArguments(args)
+
+	switch strings.TrimSpace(name) {
+	case "rgb", "rgba":
+		return parseRGBA(s, values)
+	case "hsl", "hsla":
+		return parseHSLA(s, values)
+	case "hsv", "hsva":
+		return parseHSVA(s, values)
+	case "hwb":
+		return parseHWBA(s, values)
+	case "cmyk":
+		return parseCMYK(s, values)
+	case "color":
+		return parseXYZ(s, values)
+	case "lab", "lch", "oklab", "oklch":
+		return parseLab(s, strings.TrimSpace(name), values)
+	default:
+		return nil, fmt.Errorf("%w: unknown color function in %q", ErrInvalidColor, s)
+	}
+}
+
+// splitArguments splits the arguments of a color function, separated by commas or by spaces
+// The alpha channel of the space-separated syntax, after a '/', is returned as the last argument
+func splitArguments(args string) []string {
+	if strings.Contains(args, ",") {
+		values := strings.Split(args, ",")
+		for i := range values {
+			values[i] = strings.TrimSpace(values[i])
+		}
+		return values
+	}
+
+	channels, alpha, hasAlpha := strings.Cut(args, "/")
+	values := strings.Fields(channels)
+	if hasAlpha {
+		values = append(values, strings.TrimSpace(alpha))
+	}
+	return values
+}
+
+// parseHex parses a hex color code into an RGBAColor
+func parseHex(s, str string) (Color, error) {
+	digits := strings.TrimPrefix(str, "#")
+
+	var channels []int
+	switch len(digits) {
+	case 3, 4:
+		for _, digit := range digits {
+			value, err := strconv.ParseUint(string(digit), 16, 8)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid hex digit in %q", ErrInvalidColor, s)
+			}
+			channels = append(channels, int(value)*17)
+		}
+	case 6, 8:
+		for i := 0; i < len(digits); i += 2 {
+			value, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid hex digit in %q", ErrInvalidColor, s)
+			}
+			channels = append(channels, int(value))
+		}
+	default:
+		return nil, fmt.Errorf("%w: hex code %q must have 3, 4, 6 or 8 digits", ErrInvalidColor, s)
+	}
+
+	alpha := 1.0
+	if len(channels) == 4 {
+		alpha = float64(channels[3]) / 255
+	}
+
+	return RGBAColor{Red: channels[0], Green: channels[1], Blue: channels[2], Alpha: alpha}, nil
+}
+
+// parseRGBA parses the arguments of rgb() and rgba() into an RGBAColor
+func parseRGBA(s string, values []string) (Color, error) {
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 3 or 4 values", ErrInvalidColor, s)
+	}
+
+	var channels [3]int
+	for i := range channels {
+		channel, err := parseInt(values[i], "", 0, 255)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+		}
+		channels[i] = channel
+	}
+
+	alpha, err := parseAlpha(values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	return RGBAColor{Red: channels[0], Green: channels[1], Blue: channels[2], Alpha: alpha}, nil
+}
+
+// parseHSLA parses the arguments of hsl() and hsla() into an HSLAColor
+func parseHSLA(s string, values []string) (Color, error) {
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 3 or 4 values", ErrInvalidColor, s)
+	}
+
+	hue, saturation, lightness, alpha, err := parseHueAndPercents(values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	return HSLAColor{Hue: hue, Saturation: saturation, Lightness: lightness, Alpha: alpha}, nil
+}
+
+// parseHSVA parses the arguments of hsv() and hsva() into an HSVAColor
+func parseHSVA(s string, values []string) (Color, error) {
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 3 or 4 values", ErrInvalidColor, s)
+	}
+
+	hue, saturation, value, alpha, err := parseHueAndPercents(values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	return HSVAColor{Hue: hue, Saturation: saturation, Brightness: value, Alpha: alpha}, nil
+}
+
+// parseHWBA parses the arguments of hwb() into an HWBAColor
+func parseHWBA(s string, values []string) (Color, error) {
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 3 or 4 values", ErrInvalidColor, s)
+	}
+
+	hue, whiteness, blackness, alpha, err := parseHueAndPercents(values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	return HWBAColor{Hue: hue, Whiteness: whiteness, Blackness: blackness, Alpha: alpha}, nil
+}
+
+// parseHueAndPercents parses a hue in degrees, two percents and an optional alpha channel
+func parseHueAndPercents(values []string) (hue, first, second int, alpha float64, err error) {
+	hue, err = parseInt(strings.TrimSuffix(strings.TrimSuffix(values[0], "deg"), "°"), "", 0, 360)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	first, err = parseInt(values[1], "%", 0, 100)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	second, err = parseInt(values[2], "%", 0, 100)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	alpha, err = parseAlpha(values)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	return hue, first, second, alpha, nil
+}
+
+// parseCMYK parses the arguments of cmyk() into a CMYKColor
+func parseCMYK(s string, values []string) (Color, error) {
+	if len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 4 values", ErrInvalidColor, s)
+	}
+
+	var channels [4]int
+	for i := range channels {
+		channel, err := parseInt(values[i], "%", 0, 100)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+		}
+		channels[i] = channel
+	}
+
+	return CMYKColor{Cyan: channels[0], Magenta: channels[1], Yellow: channels[2], Key: channels[3]}, nil
+}
+
+// parseXYZ parses the arguments of color(xyz-d65 ...) or color(xyz ...) into an XYZColor
+func parseXYZ(s string, values []string) (Color, error) {
+	if len(values) == 0 || (values[0] != "xyz-d65" && values[0] != "xyz") {
+		return nil, fmt.Errorf("%w: unsupported color space in %q", ErrInvalidColor, s)
+	}
+	values = values[1:]
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 3 or 4 values", ErrInvalidColor, s)
+	}
+
+	var channels [3]float64
+	for i := range channels {
+		channel, err := parseFloat(values[i], "")
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+		}
+		channels[i] = channel
+	}
+
+	alpha, err := parseAlpha(values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	return newXYZColor(channels, alpha), nil
+}
+
+// parseLab parses the arguments of lab(), lch(), oklab() and oklch() into the matching color type
+// The lightness can be a number or a percentage, and the hue of lch() and oklch() can end with "deg"
+func parseLab(s, name string, values []string) (Color, error) {
+	if len(values) != 3 && len(values) != 4 {
+		return nil, fmt.Errorf("%w: %q must have 3 or 4 values", ErrInvalidColor, s)
+	}
+
+	// The lightness percentage of OKLab is scaled to 0-1, and the one of CIELAB is already 0-100
+	lightness, err := parseFloat(values[0], "%")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+	if strings.HasPrefix(name, "ok") && strings.HasSuffix(values[0], "%") {
+		lightness /= 100
+	}
+
+	first, err := parseFloat(values[1], "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	second, err := parseFloat(strings.TrimSuffix(values[2], "deg"), "°")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	alpha, err := parseAlpha(values)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q: %v", ErrInvalidColor, s, err)
+	}
+
+	switch name {
+	case "lab":
+		return LabColor{L: lightness, A: first, B: second, Alpha: alpha}, nil
+	case "lch":
+		return LCHColor{L: lightness, C: first, H: second, Alpha: alpha}, nil
+	case "oklab":
+		return OKLabColor{L: lightness, A: first, B: second, Alpha: alpha}, nil
+	default:
+		return OKLCHColor{L: lightness, C: first, H: second, Alpha: alpha}, nil
+	}
+}
+
+// parseInt parses an integer with an optional unit suffix and checks that it is in [min, max]
+func parseInt(value, unit string, min, max int) (int, error) {
+	number, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(value, unit)))
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+	if number < min || number > max {
+		return 0, fmt.Errorf("%d is out of range [%d, %d]", number, min, max)
+	}
+	return number, nil
+}
+
+// parseFloat parses a finite number with an optional unit suffix
+func parseFloat(value, unit string) (float64, error) {
+	number, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, unit)), 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+	return number, nil
+}
+
+// parseAlpha parses the optional fourth value as an alpha channel in [0, 1], defaulting to 1.0
+// The alpha channel can also be a percentage (e.g., 50%)
+func parseAlpha(values []string) (float64, error) {
+	if len(values) < 4 {
+		return 1.0, nil
+	}
+
+	alpha, err := strconv.ParseFloat(strings.TrimSuffix(values[3], "%"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid alpha %q", values[3])
+	}
+	if strings.HasSuffix(values[3], "%") {
+		alpha /= 100
+	}
+	if !(alpha >= 0 && alpha <= 1) {
+		return 0, fmt.Errorf("alpha %v is out of range [0, 1]", alpha)
+	}
+	return alpha, nil
+}
@@ -0,0 +1,92 @@
+package color
+
+import (
+	"math"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+// hasColorRange reports whether the options constrain the hue, saturation or lightness of colors
+// Unconstrained RGBA and CMYK colors are drawn channel by channel, and constrained ones in the HSL space
+func (o Option) hasColorRange() bool {
+	return o.grayscale ||
+		o.hueMin != 0 || o.hueMax != 360 ||
+		o.saturationMin != 0 || o.saturationMax != 100 ||
+		o.lightnessMin != 0 || o.lightnessMax != 100
+}
+
+// intSource returns an integer in [0, n), like random.IntN
+// Random colors use random.IntN, and the colors of FromBytes and FromString use a hash of their input
+type intSource func(n int) int
+
+// randomHSL generates a random HSL color with full alpha within the hue, saturation and lightness ranges
+func randomHSL(o Option) HSLAColor {
+	return sourceHSL(o, random.IntN)
+}
+
+// sourceHSL draws an HSL color with full alpha within the hue, saturation and lightness ranges from a source
+func sourceHSL(o Option, intN intSource) HSLAColor {
+	hue := sourceHue(o, intN)
+	saturation := o.saturationMin + intN(o.saturationMax-o.saturationMin+1)
+	lightness := o.lightnessMin + intN(o.lightnessMax-o.lightnessMin+1)
+
+	if o.grayscale {
+		hue, saturation = 0, 0
+	}
+
+	return HSLAColor{
+		Hue:        hue,
+		Saturation: saturation,
+		Lightness:  lightness,
+		Alpha:      1.0,
+	}
+}
+
+// sourceHue draws a hue within the hue range from a source, wrapping around 360 if min is greater than max
+// The default range draws from 0 to 360 as the colors always have; other ranges return a hue of 360 as 0,
+// the same hue, so a range covering the full circle draws from 0 to 359 to not draw that hue twice as often
+func sourceHue(o Option, intN intSource) int {
+	if o.hueMin == 0 && o.hueMax == 360 {
+		return intN(361)
+	}
+
+	if o.hueMin <= o.hueMax {
+		return (o.hueMin + intN(min(o.hueMax-o.hueMin+1, 360))) % 360
+	}
+
+	return (o.hueMin + intN(360-o.hueMin+o.hueMax+1)) % 360
+}
+
+// randomAlpha generates a random alpha value in steps of 0.01 within the alpha range
+func randomAlpha(o Option) float64 {
+	return sourceAlpha(o, random.IntN)
+}
+
+// sourceAlpha draws an alpha value in steps of 0.01 within the alpha range from a source
+// If the range contains no multiple of 0.01, the minimum is returned
+func sourceAlpha(o Option, intN intSource) float64 {
+	minAlpha := int(math.Ceil(o.alphaMin*100 - 1e-9))
+	maxAlpha := int(math.Floor(o.alphaMax*100 + 1e-9))
+	if minAlpha > maxAlpha {
+		return o.alphaMin
+	}
+
+	return float64(minAlpha+intN(maxAlpha-minAlpha+1)) / 100.0
+}
+
+// hasAlphaRange reports whether the options constrain the alpha of colors
+func (o Option) hasAlphaRange() bool {
+	return o.alphaMin != 0 || o.alphaMax != 1
+}
+
+// randomSpaceColor generates the random color of the generators of the additional color spaces
+// It is drawn like RGB with the range options, and has a random alpha only with WithAlphaRange
+func randomSpaceColor(opts []OptionFunc) RGBAColor {
+	o := applyOptions(opts)
+
+	c := RGB(opts...)
+	if o.hasAlphaRange() {
+		c.Alpha = randomAlpha(o)
+	}
+	return c
+}
@@ -23,18 +23,34 @@ func (r RGBAColor) String() string {
 		r.Red, r.Green, r.Blue, r.Alpha)
 }
 
-// RGBA generates a random RGBA color
-func RGBA() RGBAColor {
+// RGBA generates a random RGBA color based on the provided options
+// With hue, saturation, lightness or grayscale options the color is drawn in the HSL space and converted
+func RGBA(opts ...OptionFunc) RGBAColor {
+	o := applyOptions(opts)
+
+	if o.hasColorRange() {
+		rgba := randomHSL(o).ToRGBA()
+		rgba.Alpha = randomAlpha(o)
+		return rgba
+	}
+
 	return RGBAColor{
-		Red:   random.IntN(256),                  // 0-255
-		Green: random.IntN(256),                  // 0-255
-		Blue:  random.IntN(256),                  // 0-255
-		Alpha: float64(random.IntN(101)) / 100.0, // 0-1 inclusive
+		Red:   random.IntN(256), // 0-255
+		Green: random.IntN(256), // 0-255
+		Blue:  random.IntN(256), // 0-255
+		Alpha: randomAlpha(o),   // 0-1 inclusive
 	}
 }
 
-// RGB generates a random RGB color with full alpha (1.0)
-func RGB() RGBAColor {
+// RGB generates a random RGB color with full alpha (1.0) based on the provided options
+// The alpha range option is ignored
+func RGB(opts ...OptionFunc) RGBAColor {
+	o := applyOptions(opts)
+
+	if o.hasColorRange() {
+		return randomHSL(o).ToRGBA()
+	}
+
 	return RGBAColor{
 		Red:   random.IntN(256), // 0-255
 		Green: random.IntN(256), // 0-255
@@ -0,0 +1,294 @@
+package color
+
+import (
+	"math"
+	"strconv"
+)
+
+// Conversions between the sRGB, CIE XYZ, CIELAB and OKLab color spaces follow CSS Color Module Level 4:
+// XYZ uses the D65 white point, CIELAB (and LCH) the D50 white point with Bradford chromatic adaptation,
+// and OKLab (and OKLCH) is computed from D65 XYZ. Channels are kept unrounded between color spaces;
+// only the conversion to RGBAColor rounds them (and gamut maps colors that sRGB cannot display).
+
+var (
+	// linearRGBToXYZ converts linear sRGB to D65 XYZ
+	linearRGBToXYZ = [3][3]float64{
+		{0.41239079926595934, 0.357584339383878, 0.1804807884018343},
+		{0.21263900587151027, 0.715168678767756, 0.07219231536073371},
+		{0.01933081871559182, 0.11919477979462598, 0.9505321522496607},
+	}
+	// xyzToLinearRGB converts D65 XYZ to linear sRGB
+	xyzToLinearRGB = [3][3]float64{
+		{3.2409699419045226, -1.537383177570094, -0.4986107602930034},
+		{-0.9692436362808796, 1.8759675015077202, 0.04155505740717559},
+		{0.05563007969699366, -0.20397695888897652, 1.0569715142428786},
+	}
+	// d65ToD50 adapts D65 XYZ to D50 XYZ with the Bradford transform
+	d65ToD50 = [3][3]float64{
+		{1.0479298208405488, 0.022946793341019088, -0.05019222954313557},
+		{0.029627815688159344, 0.990434484573249, -0.01707382502938514},
+		{-0.009243058152591178, 0.015055144896577895, 0.7518742899580008},
+	}
+	// d50ToD65 adapts D50 XYZ to D65 XYZ with the Bradford transform
+	d50ToD65 = [3][3]float64{
+		{0.9554734527042182, -0.023098536874261423, 0.0632593086610217},
+		{-0.028369706963208136, 1.0099954580058226, 0.021041398966943008},
+		{0.012314001688319899, -0.020507696433477912, 1.3303659366080753},
+	}
+	// d50White is the D50 reference white of CIELAB
+	d50White = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+	// xyzToLMS converts D65 XYZ to the cone responses of OKLab
+	xyzToLMS = [3][3]float64{
+		{0.8190224379967030, 0.3619062600528904, -0.1288737815209879},
+		{0.0329836539323885, 0.9292868615863434, 0.0361446663506424},
+		{0.0481771893596242, 0.2642395317527308, 0.6335478284694309},
+	}
+	// lmsToXYZ converts the cone responses of OKLab to D65 XYZ
+	lmsToXYZ = [3][3]float64{
+		{1.2268798758459243, -0.5578149944602171, 0.2813910456659647},
+		{-0.0405757452148008, 1.1122868032803170, -0.0717110580655164},
+		{-0.0763729366746601, -0.4214933324022432, 1.5869240198367816},
+	}
+	// lmsToOKLab converts the cube roots of the cone responses to OKLab
+	lmsToOKLab = [3][3]float64{
+		{0.2104542683093140, 0.7936177747023054, -0.0040720430116193},
+		{1.9779985324311684, -2.4285922420485799, 0.4505937096174110},
+		{0.0259040424655478, 0.7827717124575296, -0.8086757549230774},
+	}
+	// okLabToLMS converts OKLab to the cube roots of the cone responses
+	okLabToLMS = [3][3]float64{
+		{1.0, 0.3963377773761749, 0.2158037573099136},
+		{1.0, -0.1055613458156586, -0.0638541728258133},
+		{1.0, -0.0894841775298119, -1.2914855480194092},
+	}
+)
+
+const (
+	// labEpsilon and labKappa are the CIE constants of the CIELAB conversions
+	labEpsilon = 216.0 / 24389.0
+	labKappa   = 24389.0 / 27.0
+	// gamutJND is the just noticeable difference in OKLab used by gamut mapping
+	gamutJND = 0.02
+	// gamutEpsilon is the chroma precision of gamut mapping and the tolerance of the sRGB gamut check
+	gamutEpsilon = 0.0001
+	// achromaticChroma is the chroma below which polar colors have no meaningful hue (reported as 0)
+	achromaticChroma = 1e-6
+)
+
+// multiply multiplies a 3x3 matrix by a vector
+func multiply(m [3][3]float64, v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// rgbChannels returns the channels of an RGBA color in [0, 1]
+func rgbChannels(c RGBAColor) [3]float64 {
+	return [3]float64{float64(c.Red) / 255, float64(c.Green) / 255, float64(c.Blue) / 255}
+}
+
+// rgbToXYZ converts gamma-encoded sRGB channels to D65 XYZ
+func rgbToXYZ(rgb [3]float64) [3]float64 {
+	linear := func(value float64) float64 {
+		sign := 1.0
+		if value < 0 {
+			sign, value = -1, -value
+		}
+		if value <= 0.04045 {
+			return sign * value / 12.92
+		}
+		return sign * math.Pow((value+0.055)/1.055, 2.4)
+	}
+
+	return multiply(linearRGBToXYZ, [3]float64{linear(rgb[0]), linear(rgb[1]), linear(rgb[2])})
+}
+
+// xyzToRGB converts D65 XYZ to gamma-encoded sRGB channels, which are outside [0, 1] for out of gamut colors
+func xyzToRGB(xyz [3]float64) [3]float64 {
+	gamma := func(value float64) float64 {
+		sign := 1.0
+		if value < 0 {
+			sign, value = -1, -value
+		}
+		if value <= 0.0031308 {
+			return sign * value * 12.92
+		}
+		return sign * (1.055*math.Pow(value, 1/2.4) - 0.055)
+	}
+
+	linear := multiply(xyzToLinearRGB, xyz)
+	return [3]float64{gamma(linear[0]), gamma(linear[1]), gamma(linear[2])}
+}
+
+// xyzToLab converts D65 XYZ to CIELAB with the D50 white point
+func xyzToLab(xyz [3]float64) [3]float64 {
+	d50 := multiply(d65ToD50, xyz)
+
+	var f [3]float64
+	for i := range f {
+		value := d50[i] / d50White[i]
+		if value > labEpsilon {
+			f[i] = math.Cbrt(value)
+		} else {
+			f[i] = (labKappa*value + 16) / 116
+		}
+	}
+
+	return [3]float64{116*f[1] - 16, 500 * (f[0] - f[1]), 200 * (f[1] - f[2])}
+}
+
+// labToXYZ converts CIELAB with the D50 white point to D65 XYZ
+func labToXYZ(lab [3]float64) [3]float64 {
+	f1 := (lab[0] + 16) / 116
+	f0 := lab[1]/500 + f1
+	f2 := f1 - lab[2]/200
+
+	d50 := [3]float64{(116*f0 - 16) / labKappa, lab[0] / labKappa, (116*f2 - 16) / labKappa}
+	if f0*f0*f0 > labEpsilon {
+		d50[0] = f0 * f0 * f0
+	}
+	if lab[0] > labKappa*labEpsilon {
+		d50[1] = f1 * f1 * f1
+	}
+	if f2*f2*f2 > labEpsilon {
+		d50[2] = f2 * f2 * f2
+	}
+	for i := range d50 {
+		d50[i] *= d50White[i]
+	}
+
+	return multiply(d50ToD65, d50)
+}
+
+// xyzToOKLab converts D65 XYZ to OKLab
+func xyzToOKLab(xyz [3]float64) [3]float64 {
+	lms := multiply(xyzToLMS, xyz)
+	return multiply(lmsToOKLab, [3]float64{math.Cbrt(lms[0]), math.Cbrt(lms[1]), math.Cbrt(lms[2])})
+}
+
+// okLabToXYZ converts OKLab to D65 XYZ
+func okLabToXYZ(lab [3]float64) [3]float64 {
+	lms := multiply(okLabToLMS, lab)
+	return multiply(lmsToXYZ, [3]float64{lms[0] * lms[0] * lms[0], lms[1] * lms[1] * lms[1], lms[2] * lms[2] * lms[2]})
+}
+
+// toPolar converts the a and b coordinates of a Lab color space to a chroma and a hue in degrees in [0, 360)
+func toPolar(a, b float64) (chroma, hue float64) {
+	chroma = math.Hypot(a, b)
+	if chroma < achromaticChroma {
+		return chroma, 0
+	}
+	return chroma, math.Mod(math.Atan2(b, a)*180/math.Pi+360, 360)
+}
+
+// fromPolar converts a chroma and a hue in degrees to the a and b coordinates of a Lab color space
+func fromPolar(chroma, hue float64) (a, b float64) {
+	radians := hue * math.Pi / 180
+	return chroma * math.Cos(radians), chroma * math.Sin(radians)
+}
+
+// inGamut reports whether gamma-encoded sRGB channels are displayable, up to the gamut tolerance
+func inGamut(rgb [3]float64) bool {
+	for _, value := range rgb {
+		if value < -gamutEpsilon || value > 1+gamutEpsilon {
+			return false
+		}
+	}
+	return true
+}
+
+// clip clamps gamma-encoded sRGB channels to [0, 1]
+func clip(rgb [3]float64) [3]float64 {
+	for i, value := range rgb {
+		rgb[i] = math.Max(0, math.Min(1, value))
+	}
+	return rgb
+}
+
+// gamutMap converts D65 XYZ to displayable sRGB channels with the CSS Color Module Level 4 gamut mapping:
+// out of gamut colors keep their OKLCH lightness and hue, and their chroma is reduced until clipping them
+// changes them by less than a just noticeable difference
+func gamutMap(xyz [3]float64) [3]float64 {
+	rgb := xyzToRGB(xyz)
+	if inGamut(rgb) {
+		return clip(rgb)
+	}
+
+	origin := xyzToOKLab(xyz)
+	if origin[0] >= 1 {
+		return [3]float64{1, 1, 1}
+	}
+	if origin[0] <= 0 {
+		return [3]float64{0, 0, 0}
+	}
+	chroma, hue := toPolar(origin[1], origin[2])
+
+	// deltaEOK returns the OKLab distance between a color and its clipped sRGB form
+	clippedDistance := func(lab [3]float64) ([3]float64, float64) {
+		clipped := clip(xyzToRGB(okLabToXYZ(lab)))
+		return clipped, labDistance(xyzToOKLab(rgbToXYZ(clipped)), lab)
+	}
+
+	clipped, distance := clippedDistance(origin)
+	if distance < gamutJND {
+		return clipped
+	}
+
+	low, high := 0.0, chroma
+	lowInGamut := true
+	for high-low > gamutEpsilon {
+		current := (low + high) / 2
+		a, b := fromPolar(current, hue)
+		lab := [3]float64{origin[0], a, b}
+
+		if lowInGamut && inGamut(xyzToRGB(okLabToXYZ(lab))) {
+			low = current
+			continue
+		}
+
+		clipped, distance = clippedDistance(lab)
+		if distance < gamutJND {
+			if gamutJND-distance < gamutEpsilon {
+				return clipped
+			}
+			lowInGamut = false
+			low = current
+		} else {
+			high = current
+		}
+	}
+
+	return clipped
+}
+
+// labDistance returns the Euclidean distance between two colors in a Lab color space
+func labDistance(a, b [3]float64) float64 {
+	return math.Sqrt((a[0]-b[0])*(a[0]-b[0]) + (a[1]-b[1])*(a[1]-b[1]) + (a[2]-b[2])*(a[2]-b[2]))
+}
+
+// toOKLab converts an RGBA color to the lightness and a and b coordinates of the OKLab color space
+func toOKLab(c RGBAColor) [3]float64 {
+	return xyzToOKLab(rgbToXYZ(rgbChannels(c)))
+}
+
+// formatNumber formats a number for CSS color functions, rounded to the given number of decimals
+// with trailing zeros removed (e.g., 0.5 instead of 0.50)
+func formatNumber(value float64, decimals int) string {
+	scale := math.Pow(10, float64(decimals))
+	rounded := math.Round(value*scale) / scale
+	if rounded == 0 {
+		// Avoid printing negative zero
+		rounded = 0
+	}
+	return strconv.FormatFloat(rounded, 'f', -1, 64)
+}
+
+// formatAlpha returns the " / alpha" suffix of CSS color functions, or an empty string for full alpha
+func formatAlpha(alpha float64) string {
+	if alpha == 1.0 {
+		return ""
+	}
+	return " / " + formatNumber(alpha, 2)
+}
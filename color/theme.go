@@ -0,0 +1,161 @@
+package color
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+// ThemeColors holds the colors of a theme for one color mode
+type ThemeColors struct {
+	Primary   RGBAColor
+	Secondary RGBAColor
+	Surface   RGBAColor
+	Text      RGBAColor
+	Error     RGBAColor
+}
+
+// ColorTheme represents a theme with colors for light and dark mode
+type ColorTheme struct {
+	Light ThemeColors
+	Dark  ThemeColors
+}
+
+// themeRole is a color of a theme with the name of its role (e.g., "primary")
+type themeRole struct {
+	name  string
+	color RGBAColor
+}
+
+// colorToken is a color in the W3C Design Tokens format
+type colorToken struct {
+	Type  string `json:"$type"`
+	Value string `json:"$value"`
+}
+
+// themeTokens holds the color tokens of a color mode, in the order of the theme roles
+type themeTokens struct {
+	Primary   colorToken `json:"primary"`
+	Secondary colorToken `json:"secondary"`
+	Surface   colorToken `json:"surface"`
+	Text      colorToken `json:"text"`
+	Error     colorToken `json:"error"`
+}
+
+// Theme generates a random theme with light and dark mode colors
+// The primary color is random (drawn with the range options, like Palette, but with a saturation of at
+// least 40% unless the saturation range requires less) unless WithPaletteBase is used, and the secondary color
+// uses the first split-complementary hue; the error color is a red, and the surface and text colors are
+// near-white and near-black tints of the primary hue
+// In both modes the text has a contrast ratio of at least ContrastAAA with the surface, and the
+// primary, secondary and error colors have a contrast ratio of at least ContrastAA with the surface
+func Theme(opts ...OptionFunc) ColorTheme {
+	o := applyOptions(opts)
+
+	base := o.paletteBase
+	if !o.paletteHasBase {
+		baseOption := o
+		baseOption.lightnessMin, baseOption.lightnessMax = paletteLightnessRange(o)
+		if o.saturationMax >= 40 {
+			baseOption.saturationMin = max(o.saturationMin, 40)
+		}
+		base = randomHSL(baseOption)
+	}
+
+	hue, saturation := base.Hue, base.Saturation
+	secondaryHue := positiveMod(hue+schemeHueOffsets[SchemeSplitComplementary][1], 360)
+	errorHue, errorSaturation := positiveMod(355+random.IntN(16), 360), 75
+	if o.grayscale {
+		errorSaturation = 0
+	}
+
+	themeColors := func(surfaceLightness, textLightness, accentLightness int) ThemeColors {
+		surface := HSLAColor{Hue: hue, Saturation: min(saturation, 10), Lightness: surfaceLightness, Alpha: 1.0}.ToRGBA()
+		return ThemeColors{
+			Primary:   contrastingColor(HSLAColor{Hue: hue, Saturation: saturation, Lightness: accentLightness, Alpha: 1.0}, surface),
+			Secondary: contrastingColor(HSLAColor{Hue: secondaryHue, Saturation: saturation, Lightness: accentLightness, Alpha: 1.0}, surface),
+			Surface:   surface,
+			Text:      HSLAColor{Hue: hue, Saturation: min(saturation, 15), Lightness: textLightness, Alpha: 1.0}.ToRGBA(),
+			Error:     contrastingColor(HSLAColor{Hue: errorHue, Saturation: errorSaturation, Lightness: accentLightness, Alpha: 1.0}, surface),
+		}
+	}
+
+	return ColorTheme{
+		Light: themeColors(98, 12, 40),
+		Dark:  themeColors(10, 92, 70),
+	}
+}
+
+// contrastingColor moves the lightness of a color away from the surface lightness
+// until its contrast ratio with the surface is at least ContrastAA
+func contrastingColor(c HSLAColor, surface RGBAColor) RGBAColor {
+	step := -1
+	if surface.Luminance() < 0.18 {
+		step = 1
+	}
+
+	for c.ToRGBA().ContrastRatio(surface) < ContrastAA && c.Lightness+step >= 0 && c.Lightness+step <= 100 {
+		c.Lightness += step
+	}
+	return c.ToRGBA()
+}
+
+// CSS returns the theme as CSS custom properties (e.g., --color-primary), with the light mode colors
+// on the :root selector and the dark mode colors in a prefers-color-scheme: dark media query
+func (t ColorTheme) CSS() string {
+	var result strings.Builder
+
+	writeProperties := func(colors ThemeColors, indent string) {
+		for _, role := range colors.roles() {
+			result.WriteString(indent + "--color-" + role.name + ": " + tokenHex(role.color) + ";\n")
+		}
+	}
+
+	result.WriteString(":root {\n")
+	writeProperties(t.Light, "  ")
+	result.WriteString("}\n\n@media (prefers-color-scheme: dark) {\n  :root {\n")
+	writeProperties(t.Dark, "    ")
+	result.WriteString("  }\n}\n")
+
+	return result.String()
+}
+
+// DesignTokens returns the theme as indented W3C Design Tokens JSON, with a color token for each role
+// in a "light" and a "dark" group of a "color" group (e.g., color.light.primary), and the colors as hex codes
+func (t ColorTheme) DesignTokens() ([]byte, error) {
+	type modes struct {
+		Light themeTokens `json:"light"`
+		Dark  themeTokens `json:"dark"`
+	}
+
+	return json.MarshalIndent(struct {
+		Color modes `json:"color"`
+	}{modes{t.Light.tokens(), t.Dark.tokens()}}, "", "  ")
+}
+
+// roles returns the colors of the color mode with their role names, in order
+func (c ThemeColors) roles() []themeRole {
+	return []themeRole{
+		{"primary", c.Primary},
+		{"secondary", c.Secondary},
+		{"surface", c.Surface},
+		{"text", c.Text},
+		{"error", c.Error},
+	}
+}
+
+// tokens returns the colors of the color mode as design tokens
+func (c ThemeColors) tokens() themeTokens {
+	token := func(c RGBAColor) colorToken {
+		return colorToken{Type: "color", Value: tokenHex(c)}
+	}
+
+	return themeTokens{
+		Primary:   token(c.Primary),
+		Secondary: token(c.Secondary),
+		Surface:   token(c.Surface),
+		Text:      token(c.Text),
+		Error:     token(c.Error),
+	}
+}
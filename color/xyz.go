@@ -0,0 +1,82 @@
+package color
+
+import (
+	"fmt"
+)
+
+// XYZColor represents a color in the CIE XYZ color space with the D65 white point and an alpha channel
+// Y is the relative luminance, from 0 (black) to 1 (white)
+type XYZColor struct {
+	X     float64
+	Y     float64
+	Z     float64
+	Alpha float64
+}
+
+// String returns the CSS representation of the XYZ color (e.g., color(xyz-d65 0.2005 0.1506 0.0624))
+func (x XYZColor) String() string {
+	return fmt.Sprintf("color(xyz-d65 %s %s %s%s)",
+		formatNumber(x.X, 4), formatNumber(x.Y, 4), formatNumber(x.Z, 4), formatAlpha(x.Alpha))
+}
+
+// XYZ generates a random XYZ color with full alpha (1.0) based on the provided options
+// The color is drawn like RGB, so it is always in the sRGB gamut, and has a random alpha only with WithAlphaRange
+func XYZ(opts ...OptionFunc) XYZColor {
+	return randomSpaceColor(opts).ToXYZ()
+}
+
+// ToXYZ converts the RGBA color to the XYZ color space
+func (r RGBAColor) ToXYZ() XYZColor {
+	return newXYZColor(rgbToXYZ(rgbChannels(r)), r.Alpha)
+}
+
+// ToXYZ returns the XYZ color itself, so that every CIE color type can be converted to XYZ
+func (x XYZColor) ToXYZ() XYZColor {
+	return x
+}
+
+// ToRGBA converts the XYZ color to the RGBA color space, gamut mapping colors outside of sRGB
+func (x XYZColor) ToRGBA() RGBAColor {
+	rgb := gamutMap(x.vector())
+	return newRGBAColor(rgb[0], rgb[1], rgb[2], x.Alpha)
+}
+
+// ToHSLA converts the XYZ color to the HSLA color space, gamut mapping colors outside of sRGB
+func (x XYZColor) ToHSLA() HSLAColor {
+	return x.ToRGBA().ToHSLA()
+}
+
+// ToCMYK converts the XYZ color to the CMYK color space, gamut mapping colors outside of sRGB
+func (x XYZColor) ToCMYK() CMYKColor {
+	return x.ToRGBA().ToCMYK()
+}
+
+// ToLab converts the XYZ color to the CIELAB color space
+func (x XYZColor) ToLab() LabColor {
+	return newLabColor(xyzToLab(x.vector()), x.Alpha)
+}
+
+// ToLCH converts the XYZ color to the LCH color space
+func (x XYZColor) ToLCH() LCHColor {
+	return x.ToLab().ToLCH()
+}
+
+// ToOKLab converts the XYZ color to the OKLab color space
+func (x XYZColor) ToOKLab() OKLabColor {
+	return newOKLabColor(xyzToOKLab(x.vector()), x.Alpha)
+}
+
+// ToOKLCH converts the XYZ color to the OKLCH color space
+func (x XYZColor) ToOKLCH() OKLCHColor {
+	return x.ToOKLab().ToOKLCH()
+}
+
+// vector returns the X, Y and Z channels of the color
+func (x XYZColor) vector() [3]float64 {
+	return [3]float64{x.X, x.Y, x.Z}
+}
+
+// newXYZColor builds an XYZ color from its X, Y and Z channels
+func newXYZColor(xyz [3]float64, alpha float64) XYZColor {
+	return XYZColor{X: xyz[0], Y: xyz[1], Z: xyz[2], Alpha: alpha}
+}
@@ -6,7 +6,7 @@ import (
 
 // Any generates a random date
 // It can use a relative date if specified in the options
-// It can also specify how many years, months, or days to go back or forward
+// It can also specify how many years, months, or days to go back or forward, the precision and the day filters (see Between)
 func Any(opts ...OptionFunc) time.Time {
 	o := applyOptions(opts)
 
@@ -15,5 +15,5 @@ func Any(opts ...OptionFunc) time.Time {
 	endDate := o.relative.AddDate(o.years, o.months, o.days)
 
 	// Generate a random date between the start and end dates
-	return Between(startDate, endDate)
+	return Between(startDate, endDate, opts...)
 }
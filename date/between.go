@@ -1,28 +1,91 @@
 package date
 
 import (
-	"github.com/khchehab/muzayaf/random"
+	"math"
+	"math/big"
 	"time"
+
+	"github.com/khchehab/muzayaf/random"
 )
 
 // Between generates a random date between two dates
-func Between(from, to time.Time) time.Time {
+// Dates are generated at a precision of one second from the earlier date, unless WithPrecision is used
+// WithBusinessDaysOnly and WithHolidaysOnly restrict the dates to business days or public holidays;
+// if no day between the dates is allowed, the zero value is returned
+func Between(from, to time.Time, opts ...OptionFunc) time.Time {
+	o := applyOptions(opts)
+
 	// Ensure from is before to
 	if from.After(to) {
 		from, to = to, from
 	}
 
-	// Calculate the difference in seconds between the two dates
-	diff := to.Unix() - from.Unix()
+	if o.dayFilter != "" {
+		return betweenDays(from, to, o)
+	}
 
-	// If the dates are the same, return the date
-	if diff == 0 {
+	// Spans of about 292 years or more do not fit in a time.Duration, where to.Sub saturates
+	if to.Sub(from) == math.MaxInt64 {
+		return betweenLong(from, to, o.precision)
+	}
+
+	// Calculate the number of steps of the precision between the two dates
+	steps := int64(to.Sub(from) / o.precision)
+
+	// If there is no step between the dates, return the date
+	if steps == 0 {
 		return from
 	}
 
-	// Generate a random number of seconds within the range
-	randomSeconds := random.Int64N(diff + 1)
+	// Generate a random number of steps within the range
+	randomSteps := random.Int64N(steps + 1)
 
-	// Add the random number of seconds to the from date
-	return from.Add(time.Duration(randomSeconds) * time.Second)
+	// Add the random number of steps to the from date
+	return from.Add(time.Duration(randomSteps) * o.precision)
+}
+
+// betweenLong generates a random date between two dates whose span does not fit in a time.Duration
+func betweenLong(from, to time.Time, precision time.Duration) time.Time {
+	steps := new(big.Int).Quo(spanBetween(from, to), big.NewInt(int64(precision)))
+	return addDuration(from, new(big.Int).Mul(randomBigN(steps), big.NewInt(int64(precision))))
+}
+
+// spanBetween returns the nanoseconds from a date to a later one
+// It is computed from the Unix seconds and nanoseconds of the dates, since it can exceed a time.Duration
+// (about 292 years)
+func spanBetween(from, to time.Time) *big.Int {
+	span := new(big.Int).Mul(big.NewInt(to.Unix()-from.Unix()), big.NewInt(int64(time.Second)))
+	return span.Add(span, big.NewInt(int64(to.Nanosecond()-from.Nanosecond())))
+}
+
+// randomBigN returns a random integer in [0, n]
+func randomBigN(n *big.Int) *big.Int {
+	if n.IsInt64() && n.Int64() < math.MaxInt64 {
+		return big.NewInt(random.Int64N(n.Int64() + 1))
+	}
+
+	// Draw random bits of the length of n until the number is at most n
+	bits := n.BitLen()
+	for {
+		value := new(big.Int)
+		for drawn := 0; drawn < bits; drawn += 64 {
+			value.Lsh(value, 64).Or(value, new(big.Int).SetUint64(random.Uint64()))
+		}
+		value.Rsh(value, uint((64-bits%64)%64))
+
+		if value.Cmp(n) <= 0 {
+			return value
+		}
+	}
+}
+
+// addDuration adds a number of nanoseconds to a date, in parts that each fit in a time.Duration
+func addDuration(date time.Time, nanoseconds *big.Int) time.Time {
+	remaining := new(big.Int).Set(nanoseconds)
+	maxDuration := big.NewInt(math.MaxInt64)
+	for remaining.Cmp(maxDuration) > 0 {
+		date = date.Add(math.MaxInt64)
+		remaining.Sub(remaining, maxDuration)
+	}
+	return date.Add(time.Duration(remaining.Int64()))
 }
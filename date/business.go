@@ -0,0 +1,89 @@
+package date
+
+import (
+	"slices"
+	"time"
+)
+
+// BusinessHours generates a random time between two dates that falls within the working hours
+// (default is 09:00 to 17:00) of a working day (default is Monday to Friday)
+// The working hours are wall clock times in the location of the from date, unless WithLocation is used,
+// so they follow daylight saving time changes; times are generated at the precision (default is one second)
+// With WithBusinessDaysOnly the public holidays of the country are not working days
+// If no working hours are between the dates, the zero value is returned
+func BusinessHours(from, to time.Time, opts ...OptionFunc) time.Time {
+	o := applyOptions(opts)
+
+	// Ensure from is before to
+	if from.After(to) {
+		from, to = to, from
+	}
+
+	location := from.Location()
+	if o.location != nil {
+		location = o.location
+	}
+
+	// Collect the working hours between the dates, starting the day before
+	// in case the working hours of that day end after midnight
+	var windows [][2]time.Time
+	holidays := newHolidayCalendar(o.holidayCountry)
+	year, month, day := from.In(location).Date()
+	for date := time.Date(year, month, day-1, 0, 0, 0, 0, location); !date.After(to); date = date.AddDate(0, 0, 1) {
+		start, end := workingHours(date, o)
+		if !o.isWorkingDay(date, holidays) || !end.After(from) {
+			continue
+		}
+
+		start, end = maxTime(start, from), minTime(end, to)
+		if end.After(start) {
+			windows = append(windows, [2]time.Time{start, end})
+		}
+	}
+
+	if len(windows) == 0 {
+		return time.Time{}
+	}
+
+	return randomInWindows(windows, o.precision)
+}
+
+// isWorkingDay reports whether business hours can be on a day: a working day,
+// which must also be a business day with WithBusinessDaysOnly
+func (o Option) isWorkingDay(date time.Time, holidays *holidayCalendar) bool {
+	if o.dayFilter == dayFilterBusiness {
+		return o.allowsDay(date, holidays)
+	}
+	return slices.Contains(o.workDays, date.Weekday())
+}
+
+// workingHours returns the start and end of the working hours that start on the day of date
+func workingHours(date time.Time, o Option) (start, end time.Time) {
+	year, month, day := date.Date()
+	start = time.Date(year, month, day, 0, 0, 0, int(o.workStart), date.Location())
+
+	// Working hours that do not end after they start end on the next day
+	endDay := day
+	if o.workEnd <= o.workStart {
+		endDay++
+	}
+	end = time.Date(year, month, endDay, 0, 0, 0, int(o.workEnd), date.Location())
+
+	return start, end
+}
+
+// maxTime returns the later of two times
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// minTime returns the earlier of two times
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
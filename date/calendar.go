@@ -0,0 +1,195 @@
+package date
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/khchehab/muzayaf/internal"
+)
+
+// ErrInvalidCalendarDate is returned when a date does not exist in its calendar system
+var ErrInvalidCalendarDate = errors.New("invalid calendar date")
+
+// unixEpochFixed is the fixed day number of January 1, 1970, counting January 1 of year 1 (Gregorian) as day 1
+const unixEpochFixed = 719163
+
+// CalendarDate is a date in a non-Gregorian calendar system
+// Months are numbered from 1 in the order of the calendar year, except in the Hebrew calendar where they are
+// numbered from Nisan (1) to Adar (12) and Adar II (13, in leap years only), the year starting with Tishrei (7)
+type CalendarDate struct {
+	Calendar string // CalendarHijri, CalendarPersian or CalendarHebrew
+	Year     int
+	Month    int
+	Day      int
+}
+
+// calendarSystem converts the dates of a calendar system from and to fixed day numbers
+type calendarSystem struct {
+	minYear     int
+	maxYear     int
+	months      func(year int) int
+	daysInMonth func(year, month int) int
+	toFixed     func(year, month, day int) int
+	fromFixed   func(fixed int) (year, month, day int)
+}
+
+// calendarSystems maps the calendar systems to their conversions
+var calendarSystems = map[string]calendarSystem{
+	CalendarHijri:   hijriCalendar,
+	CalendarPersian: persianCalendar,
+	CalendarHebrew:  hebrewCalendar,
+}
+
+// Hijri generates a random date like Any, in the tabular Islamic (Hijri) calendar
+func Hijri(opts ...OptionFunc) CalendarDate {
+	return randomCalendarDate(CalendarHijri, opts)
+}
+
+// Persian generates a random date like Any, in the Solar Hijri (Persian) calendar
+func Persian(opts ...OptionFunc) CalendarDate {
+	return randomCalendarDate(CalendarPersian, opts)
+}
+
+// Hebrew generates a random date like Any, in the Hebrew calendar
+func Hebrew(opts ...OptionFunc) CalendarDate {
+	return randomCalendarDate(CalendarHebrew, opts)
+}
+
+// CalendarBetween generates a random date between two dates of a calendar system, both included,
+// in the calendar system of from; day filters such as WithBusinessDaysOnly are supported
+// If either date is invalid, the zero value is returned
+func CalendarBetween(from, to CalendarDate, opts ...OptionFunc) CalendarDate {
+	fromTime, err := from.Time(time.UTC)
+	if err != nil {
+		return CalendarDate{}
+	}
+	toTime, err := to.Time(time.UTC)
+	if err != nil {
+		return CalendarDate{}
+	}
+
+	date, _ := ToCalendar(Between(fromTime, toTime, append(opts, WithPrecision(24*time.Hour))...), from.Calendar)
+	return date
+}
+
+// ToCalendar converts the day of a time, in its location, to a calendar system
+// It returns an error wrapping ErrInvalidCalendarDate if the calendar system is unknown
+// or the day is out of its range
+func ToCalendar(t time.Time, calendar string) (CalendarDate, error) {
+	system, exists := calendarSystems[calendar]
+	if !exists {
+		return CalendarDate{}, fmt.Errorf("%w: unknown calendar %q", ErrInvalidCalendarDate, calendar)
+	}
+
+	year, month, day := system.fromFixed(fixedFromTime(t))
+	if year < system.minYear || year > system.maxYear {
+		return CalendarDate{}, fmt.Errorf("%w: %v is out of the range of the %s calendar", ErrInvalidCalendarDate, t.Format(time.DateOnly), calendar)
+	}
+
+	return CalendarDate{Calendar: calendar, Year: year, Month: month, Day: day}, nil
+}
+
+// Time returns the midnight of the date in a location (UTC if nil)
+// It returns an error wrapping ErrInvalidCalendarDate if the date does not exist in its calendar system
+func (d CalendarDate) Time(location *time.Location) (time.Time, error) {
+	if err := d.validate(); err != nil {
+		return time.Time{}, err
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	gregorian := time.Unix(int64(calendarSystems[d.Calendar].toFixed(d.Year, d.Month, d.Day)-unixEpochFixed)*86400, 0).UTC()
+	year, month, day := gregorian.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, location), nil
+}
+
+// String returns the date in the "year-month-day" form (e.g., "1446-09-01")
+func (d CalendarDate) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// MonthName returns the name of the month of the date in the locale of the options (default is "en"),
+// or an empty string if the date is invalid
+// Month 12 of leap years in the Hebrew calendar is Adar I
+func (d CalendarDate) MonthName(opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	if d.validate() != nil {
+		return ""
+	}
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	// Try to load the month names from the specified locale, then from "en"
+	for _, locale := range []string{o.locale, "en"} {
+		data, err := internal.LoadJsonFile("date", locale, "calendars.json")
+		if err != nil {
+			continue
+		}
+
+		if d.Calendar == CalendarHebrew && d.Month == 12 && hebrewLeapYear(d.Year) {
+			if name := internal.GetString(data, "hebrew_adar_i"); name != "" {
+				return name
+			}
+		}
+		if names := internal.GetStringSlice(data, d.Calendar); d.Month <= len(names) {
+			return names[d.Month-1]
+		}
+	}
+
+	return ""
+}
+
+// validate checks that the date exists in its calendar system
+func (d CalendarDate) validate() error {
+	system, exists := calendarSystems[d.Calendar]
+	if !exists {
+		return fmt.Errorf("%w: unknown calendar %q", ErrInvalidCalendarDate, d.Calendar)
+	}
+
+	if d.Year < system.minYear || d.Year > system.maxYear ||
+		d.Month < 1 || d.Month > system.months(d.Year) ||
+		d.Day < 1 || d.Day > system.daysInMonth(d.Year, d.Month) {
+		return fmt.Errorf("%w: %s in the %s calendar", ErrInvalidCalendarDate, d, d.Calendar)
+	}
+
+	return nil
+}
+
+// randomCalendarDate generates a random date like Any and converts it to a calendar system
+// Dates out of the range of the calendar system return the zero value
+func randomCalendarDate(calendar string, opts []OptionFunc) CalendarDate {
+	date, _ := ToCalendar(Any(opts...), calendar)
+	return date
+}
+
+// fixedFromTime returns the fixed day number of the day of a time, in its location
+func fixedFromTime(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()/86400) + unixEpochFixed
+}
+
+// fixedFromGregorian returns the fixed day number of a Gregorian date
+func fixedFromGregorian(year int, month time.Month, day int) int {
+	return fixedFromTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// floorDiv divides two integers, rounding toward negative infinity
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns the remainder of floorDiv, which has the sign of b
+func floorMod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
@@ -8,6 +8,31 @@ var (
 			"timezone": "America/New_York",
 			"weekday":  "Monday",
 			"month":    "January",
+			"long":     "{weekday}, {day} {month} {year}",
+		},
+		"ar": {
+			"timezone": "Asia/Riyadh",
+			"weekday":  "الاثنين",
+			"month":    "يناير",
+			"long":     "{weekday} {day} {month} {year}",
+		},
+		"de": {
+			"timezone": "Europe/Berlin",
+			"weekday":  "Montag",
+			"month":    "Januar",
+			"long":     "{weekday}, {day}. {month} {year}",
+		},
+		"fa": {
+			"timezone": "Asia/Tehran",
+			"weekday":  "دوشنبه",
+			"month":    "ژانویه",
+			"long":     "{weekday} {day} {month} {year}",
+		},
+		"hi": {
+			"timezone": "Asia/Kolkata",
+			"weekday":  "सोमवार",
+			"month":    "जनवरी",
+			"long":     "{weekday}, {day} {month} {year}",
 		},
 	}
 )
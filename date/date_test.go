@@ -1,7 +1,10 @@
 package date
 
 import (
+	"errors"
 	"math/rand/v2"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -96,6 +99,31 @@ func TestBetween(t *testing.T) {
 	if !date4.Equal(sameDate) {
 		t.Errorf("Between(sameDate, sameDate) = %v, want %v", date4, sameDate)
 	}
+
+	// Test ranges longer than a time.Duration (about 292 years), also at nanosecond precision
+	longFrom := time.Date(1000, 1, 1, 0, 0, 0, 0, time.UTC)
+	longTo := time.Date(3000, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, precision := range []time.Duration{time.Second, time.Nanosecond} {
+		earliest, latest := longTo, longFrom
+		for range 2000 {
+			date := Between(longFrom, longTo, WithPrecision(precision))
+			if date.Before(longFrom) || date.After(longTo) {
+				t.Fatalf("Between(%v, %v) = %v, want a date in the range", longFrom, longTo, date)
+			}
+			earliest, latest = minTime(earliest, date), maxTime(latest, date)
+		}
+		if earliest.Year() > 1100 || latest.Year() < 2900 {
+			t.Errorf("Between(%v, %v) with precision %v spans %v to %v, want the whole range", longFrom, longTo, precision, earliest, latest)
+		}
+	}
+
+	latest := time.Time{}
+	for range 2000 {
+		latest = maxTime(latest, Any(WithRelative(from), WithYears(300)))
+	}
+	if latest.Year() < 2250 {
+		t.Errorf("Any(WithYears(300)) latest date = %v, want dates up to 2300", latest)
+	}
 }
 
 // TestFuture tests the Future function
@@ -280,6 +308,972 @@ func TestTimezone(t *testing.T) {
 	}
 }
 
+// TestBetweenPrecision tests the Between function with the precision option
+func TestBetweenPrecision(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2000, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	precisionTests := []time.Duration{time.Millisecond, time.Microsecond, time.Nanosecond, time.Minute, 15 * time.Minute}
+	for _, precision := range precisionTests {
+		subSecond := false
+		for i := 0; i < 100; i++ {
+			date := Between(from, to, WithPrecision(precision))
+			if date.Before(from) || date.After(to) {
+				t.Errorf("Between() with precision %v = %v, want a date between %v and %v", precision, date, from, to)
+			}
+			if date.Sub(from)%precision != 0 {
+				t.Errorf("Between() with precision %v = %v, want a multiple of the precision", precision, date)
+			}
+			subSecond = subSecond || date.Nanosecond() != 0
+		}
+		if subSecond != (precision < time.Second) {
+			t.Errorf("Between() with precision %v generated sub-second dates: %t", precision, subSecond)
+		}
+	}
+
+	// Dates closer than the precision return the earlier date
+	if date := Between(to, to.Add(time.Millisecond), WithPrecision(time.Second)); !date.Equal(to) {
+		t.Errorf("Between() within less than the precision = %v, want %v", date, to)
+	}
+
+	// The other generators use the precision too
+	relativeDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	if date := Any(WithRelative(relativeDate), WithPrecision(24*time.Hour)); date.Sub(relativeDate)%(24*time.Hour) != 0 {
+		t.Errorf("Any() with a precision of one day = %v, want a whole number of days from %v", date, relativeDate)
+	}
+}
+
+// TestTimeOfDay tests the TimeOfDay function
+func TestTimeOfDay(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	relativeDate := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	expected := time.Date(2024, 3, 10, 17, 14, 44, 0, time.UTC)
+	if got := TimeOfDay(WithRelative(relativeDate)); !got.Equal(expected) {
+		t.Errorf("TimeOfDay() = %v, want %v", got, expected)
+	}
+
+	windowTests := []struct {
+		opts    []OptionFunc
+		hours   func(int) bool
+		minutes func(int) bool
+	}{
+		{nil, func(h int) bool { return true }, func(m int) bool { return true }},
+		{[]OptionFunc{WithHourRange(9, 17)}, func(h int) bool { return h >= 9 && h <= 17 }, func(m int) bool { return true }},
+		{[]OptionFunc{WithHourRange(22, 5)}, func(h int) bool { return h >= 22 || h <= 5 }, func(m int) bool { return true }},
+		{[]OptionFunc{WithHourRange(12, 12), WithMinuteRange(0, 14)}, func(h int) bool { return h == 12 }, func(m int) bool { return m <= 14 }},
+		{[]OptionFunc{WithMinuteRange(45, 14)}, func(h int) bool { return true }, func(m int) bool { return m >= 45 || m <= 14 }},
+		{[]OptionFunc{WithHourRange(-5, 30), WithMinuteRange(-5, 90)}, func(h int) bool { return true }, func(m int) bool { return true }},
+	}
+
+	for _, tt := range windowTests {
+		for i := 0; i < 200; i++ {
+			got := TimeOfDay(append(tt.opts, WithRelative(relativeDate))...)
+			if got.Year() != 2024 || got.Month() != time.March || got.Day() != 10 || !tt.hours(got.Hour()) || !tt.minutes(got.Minute()) || got.Nanosecond() != 0 {
+				t.Errorf("TimeOfDay() = %v, want a whole second within the windows on 2024-03-10", got)
+			}
+		}
+	}
+
+	// Precision and location
+	tokyo := time.FixedZone("JST", 9*60*60)
+	for i := 0; i < 100; i++ {
+		got := TimeOfDay(WithRelative(relativeDate), WithLocation(tokyo), WithPrecision(time.Millisecond))
+		if got.Location() != tokyo || got.Day() != 10 || got.Nanosecond()%int(time.Millisecond) != 0 {
+			t.Errorf("TimeOfDay() in Tokyo with millisecond precision = %v", got)
+		}
+		if got := TimeOfDay(WithRelative(relativeDate), WithPrecision(time.Minute)); got.Second() != 0 || got.Nanosecond() != 0 {
+			t.Errorf("TimeOfDay() with minute precision = %v, want a whole minute", got)
+		}
+		if got := TimeOfDay(WithRelative(relativeDate), WithHourRange(22, 5), WithPrecision(15*time.Minute)); got.Minute()%15 != 0 || got.Second() != 0 || (got.Hour() < 22 && got.Hour() > 5) {
+			t.Errorf("TimeOfDay() at night with a precision of 15 minutes = %v, want a quarter hour at night", got)
+		}
+	}
+
+	// Without a multiple of the precision in the ranges, the start of the ranges is returned
+	expected = time.Date(2024, 3, 10, 9, 10, 0, 0, time.UTC)
+	if got := TimeOfDay(WithRelative(relativeDate), WithHourRange(9, 9), WithMinuteRange(10, 14), WithPrecision(15*time.Minute)); !got.Equal(expected) {
+		t.Errorf("TimeOfDay() without matching times = %v, want %v", got, expected)
+	}
+}
+
+// TestDuration tests the Duration function
+func TestDuration(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	if got := Duration(time.Minute, time.Hour); got != 45*time.Minute+12*time.Second {
+		t.Errorf("Duration(1m, 1h) = %v, want 45m12s", got)
+	}
+
+	durationTests := []struct {
+		min, max  time.Duration
+		precision time.Duration
+	}{
+		{time.Minute, time.Hour, time.Second},
+		{time.Hour, time.Minute, time.Second},
+		{10 * time.Minute, 2 * time.Hour, 15 * time.Minute},
+		{time.Millisecond, 10 * time.Millisecond, time.Microsecond},
+		{-time.Hour, time.Hour, time.Minute},
+		{0, time.Nanosecond, time.Nanosecond},
+	}
+
+	for _, tt := range durationTests {
+		lo, hi := min(tt.min, tt.max), max(tt.min, tt.max)
+		for i := 0; i < 200; i++ {
+			got := Duration(tt.min, tt.max, WithPrecision(tt.precision))
+			if got < lo || got > hi || got%tt.precision != 0 {
+				t.Errorf("Duration(%v, %v) with precision %v = %v", tt.min, tt.max, tt.precision, got)
+			}
+		}
+	}
+
+	// Without a multiple of the precision in the range, min is returned
+	if got := Duration(time.Millisecond, 10*time.Millisecond); got != time.Millisecond {
+		t.Errorf("Duration(1ms, 10ms) = %v, want 1ms", got)
+	}
+}
+
+// TestBusinessHours tests the BusinessHours function
+func TestBusinessHours(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	// The range includes the start of daylight saving time on Sunday 2024-03-10
+	from := time.Date(2024, 3, 8, 0, 0, 0, 0, newYork)
+	to := time.Date(2024, 3, 16, 0, 0, 0, 0, newYork)
+
+	for i := 0; i < 500; i++ {
+		got := BusinessHours(from, to)
+		wallClock := time.Duration(got.Hour())*time.Hour + time.Duration(got.Minute())*time.Minute + time.Duration(got.Second())*time.Second
+		if got.Before(from) || got.After(to) || got.Weekday() == time.Saturday || got.Weekday() == time.Sunday ||
+			wallClock < 9*time.Hour || wallClock > 17*time.Hour || got.Location() != newYork {
+			t.Errorf("BusinessHours() = %v, want a weekday between 09:00 and 17:00 in New York", got)
+		}
+
+		// Night shifts from 22:00 to 06:00 on weekends in UTC
+		night := BusinessHours(from, to, WithWorkingHours(22*time.Hour, 6*time.Hour), WithWorkingDays(time.Saturday, time.Sunday), WithLocation(time.UTC)).UTC()
+		startDay := night
+		if night.Hour() < 22 {
+			startDay = night.AddDate(0, 0, -1)
+		}
+		if (night.Hour() < 22 && night.Hour() > 5 && !(night.Hour() == 6 && night.Minute() == 0 && night.Second() == 0)) ||
+			(startDay.Weekday() != time.Saturday && startDay.Weekday() != time.Sunday) {
+			t.Errorf("BusinessHours() for weekend night shifts = %v", night)
+		}
+
+		// Sub-second precision
+		if got := BusinessHours(from, to, WithPrecision(time.Millisecond)); got.Nanosecond()%int(time.Millisecond) != 0 {
+			t.Errorf("BusinessHours() with millisecond precision = %v", got)
+		}
+	}
+
+	// Without working hours between the dates, the zero value is returned
+	saturday := time.Date(2024, 3, 9, 10, 0, 0, 0, newYork)
+	if got := BusinessHours(saturday, saturday.Add(2*time.Hour)); !got.IsZero() {
+		t.Errorf("BusinessHours() on a Saturday = %v, want the zero value", got)
+	}
+}
+
+// TestHolidays tests the Holidays function
+func TestHolidays(t *testing.T) {
+	day := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	holidayTests := []struct {
+		country  string
+		year     int
+		name     string
+		date     time.Time
+		observed time.Time
+	}{
+		// Fixed dates observed on the nearest weekday, including in the previous year
+		{"US", 2021, "Independence Day", day(2021, 7, 4), day(2021, 7, 5)},
+		{"US", 2021, "Christmas Day", day(2021, 12, 25), day(2021, 12, 24)},
+		{"US", 2022, "New Year's Day", day(2022, 1, 1), day(2021, 12, 31)},
+		// Nth and last weekdays of a month
+		{"US", 2024, "Martin Luther King Jr. Day", day(2024, 1, 15), day(2024, 1, 15)},
+		{"US", 2024, "Memorial Day", day(2024, 5, 27), day(2024, 5, 27)},
+		{"US", 2024, "Thanksgiving Day", day(2024, 11, 28), day(2024, 11, 28)},
+		// Weekend holidays moved to the next free weekday
+		{"GB", 2021, "Christmas Day", day(2021, 12, 25), day(2021, 12, 27)},
+		{"GB", 2021, "Boxing Day", day(2021, 12, 26), day(2021, 12, 28)},
+		{"gb", 2022, "Christmas Day", day(2022, 12, 25), day(2022, 12, 27)},
+		{"GB", 2022, "Boxing Day", day(2022, 12, 26), day(2022, 12, 26)},
+		// Easter-relative holidays, and weekend holidays that are not moved
+		{"DE", 2024, "Good Friday", day(2024, 3, 29), day(2024, 3, 29)},
+		{"DE", 2024, "Easter Monday", day(2024, 4, 1), day(2024, 4, 1)},
+		{"DE", 2024, "Ascension Day", day(2024, 5, 9), day(2024, 5, 9)},
+		{"DE", 2024, "Whit Monday", day(2024, 5, 20), day(2024, 5, 20)},
+		{"FR", 2022, "Labour Day", day(2022, 5, 1), day(2022, 5, 1)},
+	}
+
+	for _, tt := range holidayTests {
+		holidays := Holidays(tt.country, tt.year)
+		index := slices.IndexFunc(holidays, func(h PublicHoliday) bool { return h.Name == tt.name })
+		if index < 0 {
+			t.Errorf("Holidays(%s, %d) has no %s", tt.country, tt.year, tt.name)
+			continue
+		}
+		if holiday := holidays[index]; !holiday.Date.Equal(tt.date) || !holiday.Observed.Equal(tt.observed) {
+			t.Errorf("Holidays(%s, %d) %s = %v observed %v, want %v observed %v",
+				tt.country, tt.year, tt.name, holiday.Date, holiday.Observed, tt.date, tt.observed)
+		}
+	}
+
+	// Counts, order, local names and years of introduction
+	countTests := []struct {
+		country string
+		year    int
+		count   int
+	}{
+		{"US", 2020, 10},
+		{"US", 2021, 11},
+		{"US", 1985, 9},
+		{"GB", 2024, 8},
+		{"DE", 2024, 9},
+		{"DE", 1989, 8},
+		{"FR", 2024, 11},
+		{"XX", 2024, 0},
+		{"../date", 2024, 0},
+		{"", 2024, 0},
+	}
+
+	for _, tt := range countTests {
+		holidays := Holidays(tt.country, tt.year)
+		if len(holidays) != tt.count {
+			t.Errorf("Holidays(%q, %d) returned %d holidays, want %d", tt.country, tt.year, len(holidays), tt.count)
+		}
+		if !slices.IsSortedFunc(holidays, func(a, b PublicHoliday) int { return a.Date.Compare(b.Date) }) {
+			t.Errorf("Holidays(%q, %d) is not sorted by date", tt.country, tt.year)
+		}
+	}
+
+	if holiday := Holidays("DE", 2024)[0]; holiday.Name != "New Year's Day" || holiday.LocalName != "Neujahr" {
+		t.Errorf("Holidays(DE, 2024)[0] = %v, want New Year's Day (Neujahr)", holiday)
+	}
+	if holiday := Holidays("US", 2024)[0]; holiday.LocalName != holiday.Name {
+		t.Errorf("Holidays(US, 2024)[0] local name = %q, want %q", holiday.LocalName, holiday.Name)
+	}
+}
+
+// TestEasterSunday tests the easterSunday function
+func TestEasterSunday(t *testing.T) {
+	easterTests := map[int]time.Time{
+		1961: time.Date(1961, 4, 2, 0, 0, 0, 0, time.UTC),
+		2000: time.Date(2000, 4, 23, 0, 0, 0, 0, time.UTC),
+		2008: time.Date(2008, 3, 23, 0, 0, 0, 0, time.UTC),
+		2019: time.Date(2019, 4, 21, 0, 0, 0, 0, time.UTC),
+		2024: time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		2038: time.Date(2038, 4, 25, 0, 0, 0, 0, time.UTC),
+	}
+
+	for year, expected := range easterTests {
+		if got := easterSunday(year); !got.Equal(expected) {
+			t.Errorf("easterSunday(%d) = %v, want %v", year, got, expected)
+		}
+	}
+}
+
+// TestHoliday tests the Holiday function
+func TestHoliday(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	if holiday := Holiday("US", 2024); holiday.Name != "Veterans Day" {
+		t.Errorf("Holiday(US, 2024) = %v, want Veterans Day", holiday)
+	}
+
+	for i := 0; i < 100; i++ {
+		if holiday := Holiday("FR", 2024); holiday.Date.Year() != 2024 || holiday.Name == "" {
+			t.Errorf("Holiday(FR, 2024) = %v, want a holiday in 2024", holiday)
+		}
+	}
+
+	if holiday := Holiday("XX", 2024); holiday != (PublicHoliday{}) {
+		t.Errorf("Holiday(XX, 2024) = %v, want the zero value", holiday)
+	}
+}
+
+// TestDayFilters tests the WithBusinessDaysOnly and WithHolidaysOnly options
+func TestDayFilters(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	from := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC)
+	isHoliday := func(date time.Time, country string) bool {
+		for _, year := range []int{date.Year(), date.Year() + 1} {
+			for _, holiday := range Holidays(country, year) {
+				for _, day := range []time.Time{holiday.Date, holiday.Observed} {
+					if day.Year() == date.Year() && day.YearDay() == date.YearDay() {
+						return true
+					}
+				}
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < 300; i++ {
+		business := Between(from, to, WithBusinessDaysOnly("US"))
+		if business.Before(from) || business.After(to) || business.Weekday() == time.Saturday || business.Weekday() == time.Sunday || isHoliday(business, "US") {
+			t.Errorf("Between() with business days only = %v, want a business day", business)
+		}
+
+		holiday := Between(from, to, WithHolidaysOnly("GB"), WithPrecision(time.Millisecond))
+		if holiday.Before(from) || holiday.After(to) || !isHoliday(holiday, "GB") {
+			t.Errorf("Between() with holidays only = %v, want a holiday", holiday)
+		}
+
+		// Custom working days and other generators
+		relativeDate := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		past := Past(WithRelative(relativeDate), WithYears(1), WithBusinessDaysOnly("DE"), WithWorkingDays(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday))
+		if past.After(relativeDate) || past.Weekday() == time.Friday || past.Weekday() == time.Saturday || isHoliday(past, "DE") {
+			t.Errorf("Past() with business days from Sunday to Thursday = %v", past)
+		}
+		future := Future(WithRelative(relativeDate), WithYears(1), WithHolidaysOnly("FR"))
+		if future.Before(relativeDate) || !isHoliday(future, "FR") {
+			t.Errorf("Future() with holidays only = %v, want a holiday", future)
+		}
+
+		// Business hours skip holidays with business days only
+		hours := BusinessHours(from, to, WithBusinessDaysOnly("US"))
+		if isHoliday(hours, "US") || hours.Hour() < 9 || hours.Hour() > 17 {
+			t.Errorf("BusinessHours() with business days only = %v", hours)
+		}
+	}
+
+	// Without an allowed day in the range, the zero value is returned
+	christmasEve := time.Date(2022, 12, 24, 10, 0, 0, 0, time.UTC)
+	if got := Between(christmasEve, christmasEve.Add(time.Hour), WithBusinessDaysOnly("GB")); !got.IsZero() {
+		t.Errorf("Between() on Christmas Eve with business days only = %v, want the zero value", got)
+	}
+	if got := Between(christmasEve, christmasEve.Add(time.Hour), WithHolidaysOnly("GB")); !got.IsZero() {
+		t.Errorf("Between() on Christmas Eve with holidays only = %v, want the zero value", got)
+	}
+	saturday := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	if got := Between(saturday, saturday.Add(23*time.Hour), WithBusinessDaysOnly("US")); !got.IsZero() {
+		t.Errorf("Between() on a Saturday with business days only = %v, want the zero value", got)
+	}
+
+	// Precisions longer than a day are reduced to a day
+	for range 100 {
+		got := Between(saturday, saturday.AddDate(0, 0, 7), WithBusinessDaysOnly("US"), WithPrecision(48*time.Hour))
+		if got.Before(saturday) || got.After(saturday.AddDate(0, 0, 7)) || got.Weekday() == time.Saturday || got.Weekday() == time.Sunday || got.Hour() != 0 {
+			t.Fatalf("Between() with business days only and a precision of two days = %v, want the start of a weekday", got)
+		}
+	}
+
+	// Business days of ranges longer than a time.Duration (about 292 years)
+	longFrom := time.Date(1700, 1, 1, 0, 0, 0, 0, time.UTC)
+	longTo := time.Date(2150, 1, 1, 0, 0, 0, 0, time.UTC)
+	latest := longFrom
+	for range 10 {
+		date := Between(longFrom, longTo, WithBusinessDaysOnly("FR"))
+		if date.Before(longFrom) || date.After(longTo) || date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			t.Fatalf("Between(%v, %v) with business days only = %v", longFrom, longTo, date)
+		}
+		latest = maxTime(latest, date)
+	}
+	if latest.Year() < 2000 {
+		t.Errorf("Between(%v, %v) with business days only latest date = %v, want dates up to 2150", longFrom, longTo, latest)
+	}
+}
+
+// TestTimezoneFilters tests the time zone filters of the Timezone and Location functions
+func TestTimezoneFilters(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	relative := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	for range 20 {
+		name := Timezone(WithRelative(relative), WithContinents("europe", "Africa"))
+		if !strings.HasPrefix(name, "Europe/") && !strings.HasPrefix(name, "Africa/") {
+			t.Errorf("Timezone(WithContinents(\"europe\", \"Africa\")) = %v, want a European or African time zone", name)
+		}
+
+		location := Location(WithRelative(relative), WithUTCOffsetRange(-3*time.Hour, -5*time.Hour))
+		if _, offset := relative.In(location).Zone(); offset < -5*3600 || offset > -3*3600 {
+			t.Errorf("Location(WithUTCOffsetRange(-3h, -5h)) = %v with offset %d, want an offset from -5h to -3h", location, offset)
+		}
+
+		if location := Location(WithRelative(relative), WithDST(true)); !observesDST(location, 2024) {
+			t.Errorf("Location(WithDST(true)) = %v, want a time zone observing DST", location)
+		}
+		if location := Location(WithRelative(relative), WithDST(false)); observesDST(location, 2024) {
+			t.Errorf("Location(WithDST(false)) = %v, want a time zone not observing DST", location)
+		}
+	}
+
+	// Filters without any matching time zone
+	if name := Timezone(WithContinents("Atlantis")); name != "" {
+		t.Errorf("Timezone(WithContinents(\"Atlantis\")) = %v, want an empty string", name)
+	}
+	if location := Location(WithContinents("Atlantis")); location != time.UTC {
+		t.Errorf("Location(WithContinents(\"Atlantis\")) = %v, want UTC", location)
+	}
+}
+
+// TestZoned tests the Zoned and ZonedBetween functions
+func TestZoned(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	from := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	for range 20 {
+		date := ZonedBetween(from, to, WithLocation(tokyo))
+		if date.Location() != tokyo || date.Before(from) || date.After(to) {
+			t.Errorf("ZonedBetween(%v, %v, WithLocation(Asia/Tokyo)) = %v, want a date in Asia/Tokyo between the dates", from, to, date)
+		}
+
+		date = ZonedBetween(from, to, WithContinents("Australia"))
+		if !strings.HasPrefix(date.Location().String(), "Australia/") || date.Before(from) || date.After(to) {
+			t.Errorf("ZonedBetween(%v, %v, WithContinents(\"Australia\")) = %v, want a date in Australia between the dates", from, to, date)
+		}
+
+		// Business days are those of the time zone of the date
+		date = Zoned(WithRelative(from), WithYears(1), WithContinents("Pacific"), WithBusinessDaysOnly("US"))
+		if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			t.Errorf("Zoned(WithBusinessDaysOnly(\"US\")) = %v, want a weekday in its time zone", date)
+		}
+	}
+}
+
+// TestDSTEdge tests the DSTEdge function
+func TestDSTEdge(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone database not available: %v", err)
+	}
+
+	// Known transitions of New York in 2024
+	transitions := dstTransitions(newYork, 2024, "")
+	if len(transitions) != 2 {
+		t.Fatalf("dstTransitions(America/New_York, 2024) returned %d transitions, want 2", len(transitions))
+	}
+	if want := time.Date(2024, time.March, 10, 7, 0, 0, 0, time.UTC); !transitions[0].at.Equal(want) {
+		t.Errorf("dstTransitions(America/New_York, 2024)[0] = %v, want %v", transitions[0].at, want)
+	}
+	if want := time.Date(2024, time.November, 3, 6, 0, 0, 0, time.UTC); !transitions[1].at.Equal(want) {
+		t.Errorf("dstTransitions(America/New_York, 2024)[1] = %v, want %v", transitions[1].at, want)
+	}
+
+	edge := newDSTEdgeCase(transitions[0], newYork, time.Hour)
+	if edge.Kind != DSTNonexistent || edge.Local != "2024-03-10 02:00:00" {
+		t.Errorf("newDSTEdgeCase(spring forward) = %v %v, want nonexistent 2024-03-10 02:00:00", edge.Kind, edge.Local)
+	}
+	if edge.Before.Format(time.DateTime) != "2024-03-10 03:00:00" || edge.After.Format(time.DateTime) != "2024-03-10 01:00:00" {
+		t.Errorf("newDSTEdgeCase(spring forward) = %v and %v, want 03:00 EDT and 01:00 EST", edge.Before, edge.After)
+	}
+
+	edge = newDSTEdgeCase(transitions[1], newYork, time.Hour)
+	if edge.Kind != DSTAmbiguous || edge.Local != "2024-11-03 01:00:00" {
+		t.Errorf("newDSTEdgeCase(fall back) = %v %v, want ambiguous 2024-11-03 01:00:00", edge.Kind, edge.Local)
+	}
+	if name, _ := edge.Before.Zone(); name != "EDT" {
+		t.Errorf("newDSTEdgeCase(fall back).Before = %v, want 01:00 EDT", edge.Before)
+	}
+	if name, _ := edge.After.Zone(); name != "EST" {
+		t.Errorf("newDSTEdgeCase(fall back).After = %v, want 01:00 EST", edge.After)
+	}
+
+	// Nonexistent times are never the wall clock time of their instants, and ambiguous times always are
+	relative := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	for range 20 {
+		edge := DSTEdge(WithRelative(relative), WithYears(10), WithDSTEdgeKind(DSTNonexistent))
+		if edge.Kind != DSTNonexistent || edge.Before.Format(time.DateTime) == edge.Local || edge.After.Format(time.DateTime) == edge.Local {
+			t.Errorf("DSTEdge(WithDSTEdgeKind(DSTNonexistent)) = %+v, want a nonexistent time", edge)
+		}
+
+		edge = DSTEdge(WithRelative(relative), WithYears(10), WithDSTEdgeKind(DSTAmbiguous), WithContinents("Europe"))
+		if edge.Kind != DSTAmbiguous || edge.Before.Format(time.DateTime) != edge.Local || edge.After.Format(time.DateTime) != edge.Local ||
+			!edge.Before.Before(edge.After) || !strings.HasPrefix(edge.Location.String(), "Europe/") {
+			t.Errorf("DSTEdge(WithDSTEdgeKind(DSTAmbiguous)) = %+v, want an ambiguous time in Europe", edge)
+		}
+	}
+
+	// Transitions of years beyond 2037, where ZoneBounds can stop moving forward at the end of leap years
+	paris, _ := time.LoadLocation("Europe/Paris")
+	chicago, _ := time.LoadLocation("America/Chicago")
+	futureTests := []struct {
+		location *time.Location
+		year     int
+		expected []string
+	}{
+		{paris, 2040, []string{"2040-03-25 01:00:00", "2040-10-28 01:00:00"}},
+		{paris, 2044, []string{"2044-03-27 01:00:00", "2044-10-30 01:00:00"}},
+		{paris, 2116, []string{"2116-03-29 01:00:00", "2116-10-25 01:00:00"}},
+		{chicago, 2040, []string{"2040-03-11 08:00:00", "2040-11-04 07:00:00"}},
+		{chicago, 2128, []string{"2128-03-14 08:00:00", "2128-11-07 07:00:00"}},
+	}
+	for _, test := range futureTests {
+		transitions := dstTransitions(test.location, test.year, "")
+		var got []string
+		for _, transition := range transitions {
+			got = append(got, transition.at.UTC().Format(time.DateTime))
+		}
+		if !slices.Equal(got, test.expected) {
+			t.Errorf("dstTransitions(%v, %d) = %v, want %v", test.location, test.year, got, test.expected)
+		}
+	}
+	for range 20 {
+		if edge := DSTEdge(WithRelative(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC)), WithYears(40)); edge.Location == nil {
+			t.Errorf("DSTEdge() around 2100 = %+v, want a DST edge case", edge)
+		}
+	}
+
+	// No matching time zone
+	if edge := DSTEdge(WithContinents("Atlantis")); edge.Location != nil {
+		t.Errorf("DSTEdge(WithContinents(\"Atlantis\")) = %+v, want the zero value", edge)
+	}
+}
+
+// TestTimezoneInfo tests the TimezoneInfo function
+func TestTimezoneInfo(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	info := TimezoneInfo()
+	expected := TimezoneMetadata{
+		Name:                 "Europe/Athens",
+		Country:              "GR",
+		Canonical:            true,
+		StandardAbbreviation: "EET",
+		DaylightAbbreviation: "EEST",
+		StandardOffset:       2 * time.Hour,
+	}
+	if info != expected {
+		t.Errorf("TimezoneInfo() = %+v, want %+v", info, expected)
+	}
+
+	// Filters on the country and the rules of the time zones
+	info = TimezoneInfo(WithCountries("us"), WithDST(false), WithRelative(time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)))
+	if info.Country != "US" || info.DaylightAbbreviation != "" {
+		t.Errorf("TimezoneInfo(WithCountries(\"us\"), WithDST(false)) = %+v, want a US time zone without DST", info)
+	}
+	for range 20 {
+		if name := Timezone(WithCountries("DE")); name != "Europe/Berlin" && name != "Europe/Busingen" {
+			t.Errorf("Timezone(WithCountries(\"DE\")) = %v, want a German time zone", name)
+		}
+	}
+	if info := TimezoneInfo(WithCountries("XX")); info != (TimezoneMetadata{}) {
+		t.Errorf("TimezoneInfo(WithCountries(\"XX\")) = %+v, want the zero value", info)
+	}
+
+	// The metadata covers every time zone, and aliases link to canonical zones, listed or not (e.g., "Europe/Kyiv")
+	metadata := loadTimezoneMetadata("en")
+	zones := make(map[string]TimezoneMetadata, len(metadata))
+	for _, zone := range metadata {
+		zones[zone.Name] = zone
+	}
+	for _, name := range loadTimezones("en") {
+		if _, exists := zones[name]; !exists {
+			t.Errorf("loadTimezoneMetadata() has no metadata for %v", name)
+		}
+	}
+	for _, zone := range metadata {
+		if zone.Canonical != (zone.LinkTo == "") {
+			t.Errorf("loadTimezoneMetadata()[%v] is canonical %v with link %q", zone.Name, zone.Canonical, zone.LinkTo)
+		}
+		if target, exists := zones[zone.LinkTo]; zone.LinkTo != "" && ((exists && !target.Canonical) || loadLocation(zone.LinkTo) == nil) {
+			t.Errorf("loadTimezoneMetadata()[%v] links to %v, want a canonical time zone", zone.Name, zone.LinkTo)
+		}
+	}
+
+	// Known metadata
+	for _, want := range []TimezoneMetadata{
+		{Name: "America/New_York", Country: "US", Canonical: true, StandardAbbreviation: "EST", DaylightAbbreviation: "EDT", StandardOffset: -5 * time.Hour},
+		{Name: "Asia/Kolkata", Country: "IN", Canonical: true, StandardAbbreviation: "IST", StandardOffset: 5*time.Hour + 30*time.Minute},
+		{Name: "Europe/Oslo", Country: "NO", LinkTo: "Europe/Berlin", StandardAbbreviation: "CET", DaylightAbbreviation: "CEST", StandardOffset: time.Hour},
+		{Name: "Europe/Dublin", Country: "IE", Canonical: true, StandardAbbreviation: "GMT", DaylightAbbreviation: "IST"},
+		{Name: "UTC", LinkTo: "Etc/UTC", StandardAbbreviation: "UTC"},
+	} {
+		if zones[want.Name] != want {
+			t.Errorf("loadTimezoneMetadata()[%v] = %+v, want %+v", want.Name, zones[want.Name], want)
+		}
+	}
+}
+
+// TestParseUTCOffset tests the parseUTCOffset function
+func TestParseUTCOffset(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		ok       bool
+	}{
+		{"+00:00", 0, true},
+		{"+05:45", 5*time.Hour + 45*time.Minute, true},
+		{"-09:30", -9*time.Hour - 30*time.Minute, true},
+		{"+14:00", 14 * time.Hour, true},
+		{"05:00", 0, false},
+		{"+5:00", 0, false},
+		{"+05:60", 0, false},
+		{"", 0, false},
+	}
+
+	for _, test := range tests {
+		offset, ok := parseUTCOffset(test.input)
+		if offset != test.expected || ok != test.ok {
+			t.Errorf("parseUTCOffset(%q) = %v, %v, want %v, %v", test.input, offset, ok, test.expected, test.ok)
+		}
+	}
+}
+
+// TestFormatted tests the Formatted function
+func TestFormatted(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	relative := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// With a fixed seed, we should get consistent results
+	formatted1 := Formatted(WithRelative(relative))
+	formatted2 := Formatted(WithRelative(relative), WithYears(1), WithLayouts(LayoutLong), WithLocale("de"))
+
+	if expected := "2074-11-04T20:42:06Z"; formatted1 != expected {
+		t.Errorf("Formatted() = %v, want %v", formatted1, expected)
+	}
+	if expected := "Mittwoch, 25. Juni 2025"; formatted2 != expected {
+		t.Errorf("Formatted(WithLayouts(LayoutLong), WithLocale(\"de\")) = %v, want %v", formatted2, expected)
+	}
+
+	// Formatted dates can be parsed back with their layout
+	for range 20 {
+		formatted := Formatted(WithRelative(relative), WithLayouts(LayoutRFC3339Nano), WithPrecision(time.Millisecond))
+		if _, err := time.Parse(time.RFC3339Nano, formatted); err != nil {
+			t.Errorf("Formatted(WithLayouts(LayoutRFC3339Nano)) = %v, want an RFC 3339 date: %v", formatted, err)
+		}
+	}
+
+	// Custom Go layouts and locations
+	tokyo := time.FixedZone("JST", 9*3600)
+	formatted := Formatted(WithRelative(relative), WithLayouts("2006-01-02 15:04 MST"), WithLocation(tokyo))
+	if !strings.HasSuffix(formatted, " JST") {
+		t.Errorf("Formatted(WithLayouts(\"2006-01-02 15:04 MST\"), WithLocation(JST)) = %v, want a date in JST", formatted)
+	}
+}
+
+// TestFormatDate tests the formatDate function
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2025, time.March, 4, 14, 30, 5, 123000000, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		layout   string
+		locale   string
+		expected string
+	}{
+		{LayoutISO8601Date, "en", "2025-03-04"},
+		{LayoutISO8601Basic, "en", "20250304T143005+0100"},
+		{LayoutRFC3339, "en", "2025-03-04T14:30:05+01:00"},
+		{LayoutRFC3339Nano, "en", "2025-03-04T14:30:05.123+01:00"},
+		{LayoutRFC1123, "en", "Tue, 04 Mar 2025 14:30:05 CET"},
+		{LayoutUnix, "en", "1741095005"},
+		{LayoutUnixMilli, "en", "1741095005123"},
+		{LayoutMonthDayYear, "en", "03/04/2025"},
+		{LayoutDayMonthYear, "en", "04/03/2025"},
+		{LayoutLong, "en", "Tuesday, 4 March 2025"},
+		{LayoutLong, "ar", "الثلاثاء ٤ مارس ٢٠٢٥"},
+		{LayoutLong, "de", "Dienstag, 4. März 2025"},
+		{LayoutLong, "fa", "سه‌شنبه ۴ مارس ۲۰۲۵"},
+		{LayoutLong, "hi", "मंगलवार, 4 मार्च 2025"},
+		{"Jan 2, 2006 3:04 PM", "en", "Mar 4, 2025 2:30 PM"},
+	}
+
+	for _, test := range tests {
+		if formatted := formatDate(date, test.layout, test.locale); formatted != test.expected {
+			t.Errorf("formatDate(%v, %q, %q) = %v, want %v", date, test.layout, test.locale, formatted, test.expected)
+		}
+	}
+}
+
+// TestMonthValue tests the MonthValue and MonthName functions
+func TestMonthValue(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	month1 := MonthValue()
+	month2 := MonthValue(WithLocale("de"))
+
+	if month1 != time.September {
+		t.Errorf("MonthValue() = %v, want %v", month1, time.September)
+	}
+	if month2 != time.March {
+		t.Errorf("MonthValue() second call = %v, want %v", month2, time.March)
+	}
+
+	// Abbreviated and narrow names
+	if name := Month(WithNameWidth(NameAbbreviated)); name != "Sep" {
+		t.Errorf("Month(WithNameWidth(NameAbbreviated)) = %v, want Sep", name)
+	}
+
+	tests := []struct {
+		month    time.Month
+		locale   string
+		width    string
+		expected string
+	}{
+		{time.March, "en", NameWide, "March"},
+		{time.March, "en", NameAbbreviated, "Mar"},
+		{time.March, "en", NameNarrow, "M"},
+		{time.March, "de", NameAbbreviated, "Mär"},
+		{time.May, "ar", NameWide, "مايو"},
+		{time.January, "fa", NameNarrow, "ژ"},
+		{time.March, "non-existent", NameWide, "March"},
+		{time.March, "en", "invalid", "March"},
+		{time.Month(13), "en", NameWide, ""},
+	}
+
+	for _, test := range tests {
+		if name := MonthName(test.month, WithLocale(test.locale), WithNameWidth(test.width)); name != test.expected {
+			t.Errorf("MonthName(%v, %q, %q) = %v, want %v", test.month, test.locale, test.width, name, test.expected)
+		}
+	}
+}
+
+// TestWeekdayValue tests the WeekdayValue and WeekdayName functions
+func TestWeekdayValue(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	if weekday := WeekdayValue(); weekday != time.Friday {
+		t.Errorf("WeekdayValue() = %v, want %v", weekday, time.Friday)
+	}
+
+	// Weekend days and the other days of the locale
+	for range 20 {
+		if weekday := WeekdayValue(WithWeekend(true), WithLocale("ar")); weekday != time.Friday && weekday != time.Saturday {
+			t.Errorf("WeekdayValue(WithWeekend(true), WithLocale(\"ar\")) = %v, want Friday or Saturday", weekday)
+		}
+		if weekday := WeekdayValue(WithWeekend(false)); weekday == time.Saturday || weekday == time.Sunday {
+			t.Errorf("WeekdayValue(WithWeekend(false)) = %v, want a day from Monday to Friday", weekday)
+		}
+		if name := Weekday(WithWeekend(true), WithLocale("fa")); name != "جمعه" {
+			t.Errorf("Weekday(WithWeekend(true), WithLocale(\"fa\")) = %v, want جمعه", name)
+		}
+	}
+
+	tests := []struct {
+		weekday  time.Weekday
+		locale   string
+		width    string
+		expected string
+	}{
+		{time.Wednesday, "en", NameWide, "Wednesday"},
+		{time.Wednesday, "en", NameAbbreviated, "Wed"},
+		{time.Wednesday, "en", NameNarrow, "W"},
+		{time.Sunday, "de", NameAbbreviated, "So"},
+		{time.Friday, "ar", NameWide, "الجمعة"},
+		{time.Monday, "hi", NameAbbreviated, "सोम"},
+		{time.Sunday, "non-existent", NameWide, "Sunday"},
+		{time.Weekday(7), "en", NameWide, ""},
+	}
+
+	for _, test := range tests {
+		if name := WeekdayName(test.weekday, WithLocale(test.locale), WithNameWidth(test.width)); name != test.expected {
+			t.Errorf("WeekdayName(%v, %q, %q) = %v, want %v", test.weekday, test.locale, test.width, name, test.expected)
+		}
+	}
+}
+
+// TestWeekRules tests the FirstDayOfWeek and Weekend functions
+func TestWeekRules(t *testing.T) {
+	tests := []struct {
+		locale   string
+		firstDay time.Weekday
+		weekend  []time.Weekday
+	}{
+		{"en", time.Sunday, []time.Weekday{time.Saturday, time.Sunday}},
+		{"de", time.Monday, []time.Weekday{time.Saturday, time.Sunday}},
+		{"ar", time.Saturday, []time.Weekday{time.Friday, time.Saturday}},
+		{"fa", time.Saturday, []time.Weekday{time.Friday}},
+		{"hi", time.Sunday, []time.Weekday{time.Sunday}},
+		{"non-existent", time.Sunday, []time.Weekday{time.Saturday, time.Sunday}},
+	}
+
+	for _, test := range tests {
+		if firstDay := FirstDayOfWeek(WithLocale(test.locale)); firstDay != test.firstDay {
+			t.Errorf("FirstDayOfWeek(WithLocale(%q)) = %v, want %v", test.locale, firstDay, test.firstDay)
+		}
+		if weekend := Weekend(WithLocale(test.locale)); !slices.Equal(weekend, test.weekend) {
+			t.Errorf("Weekend(WithLocale(%q)) = %v, want %v", test.locale, weekend, test.weekend)
+		}
+	}
+
+	// Invalid week data falls back to the "en" week, and a weekend must leave a working day
+	invalid := []map[string]any{
+		nil,
+		{"first_day": "someday", "weekend": []any{"caturday"}},
+		{"first_day": "monday", "weekend": []any{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}},
+	}
+	firstDays := []time.Weekday{time.Sunday, time.Sunday, time.Monday}
+	for i, data := range invalid {
+		rules := parseWeekRules(data)
+		if rules.firstDay != firstDays[i] || !slices.Equal(rules.weekend, []time.Weekday{time.Saturday, time.Sunday}) {
+			t.Errorf("parseWeekRules(%v) = %+v, want first day %v and the \"en\" weekend", data, rules, firstDays[i])
+		}
+	}
+}
+
+// TestCalendars tests the Hijri, Persian, Hebrew and CalendarBetween functions
+func TestCalendars(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	relative := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	// With a fixed seed, we should get consistent results
+	tests := []struct {
+		date     CalendarDate
+		expected CalendarDate
+	}{
+		{Hijri(WithRelative(relative)), CalendarDate{CalendarHijri, 1497, 11, 14}},
+		{Persian(WithRelative(relative)), CalendarDate{CalendarPersian, 1352, 3, 2}},
+		{Hebrew(WithRelative(relative)), CalendarDate{CalendarHebrew, 5833, 13, 7}},
+	}
+
+	for _, test := range tests {
+		if test.date != test.expected {
+			t.Errorf("%s calendar date = %v, want %v", test.expected.Calendar, test.date, test.expected)
+		}
+	}
+
+	// Dates between two dates of a calendar, here during Ramadan 1446
+	from := CalendarDate{Calendar: CalendarHijri, Year: 1446, Month: 9, Day: 1}
+	to := CalendarDate{Calendar: CalendarHijri, Year: 1446, Month: 9, Day: 29}
+	for range 20 {
+		if date := CalendarBetween(from, to); date.Calendar != CalendarHijri || date.Year != 1446 || date.Month != 9 {
+			t.Errorf("CalendarBetween(%v, %v) = %v, want a date in Ramadan 1446", from, to, date)
+		}
+	}
+	if date := CalendarBetween(CalendarDate{Calendar: CalendarHijri, Year: 1446, Month: 2, Day: 30}, to); date != (CalendarDate{}) {
+		t.Errorf("CalendarBetween() with an invalid date = %v, want the zero value", date)
+	}
+}
+
+// TestCalendarConversions tests the conversions between calendar dates and times
+func TestCalendarConversions(t *testing.T) {
+	tests := []struct {
+		gregorian time.Time
+		date      CalendarDate
+		monthName string
+	}{
+		{time.Date(622, time.July, 19, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHijri, 1, 1, 1}, "Muharram"},
+		{time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHijri, 1446, 9, 1}, "Ramadan"},
+		{time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarPersian, 1403, 1, 1}, "Farvardin"},
+		{time.Date(2025, time.March, 20, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarPersian, 1403, 12, 30}, "Esfand"},
+		{time.Date(2025, time.March, 21, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarPersian, 1404, 1, 1}, "Farvardin"},
+		{time.Date(1979, time.February, 11, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarPersian, 1357, 11, 22}, "Bahman"},
+		{time.Date(2024, time.October, 3, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHebrew, 5785, 7, 1}, "Tishrei"},
+		{time.Date(2024, time.April, 23, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHebrew, 5784, 1, 15}, "Nisan"},
+		{time.Date(2024, time.February, 24, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHebrew, 5784, 12, 15}, "Adar I"},
+		{time.Date(2024, time.March, 24, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHebrew, 5784, 13, 14}, "Adar II"},
+		{time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC), CalendarDate{CalendarHebrew, 5785, 12, 14}, "Adar"},
+	}
+
+	for _, test := range tests {
+		date, err := ToCalendar(test.gregorian, test.date.Calendar)
+		if err != nil || date != test.date {
+			t.Errorf("ToCalendar(%v, %q) = %v, %v, want %v", test.gregorian.Format(time.DateOnly), test.date.Calendar, date, err, test.date)
+		}
+
+		gregorian, err := test.date.Time(nil)
+		if err != nil || !gregorian.Equal(test.gregorian) {
+			t.Errorf("%v.Time(nil) = %v, %v, want %v", test.date, gregorian, err, test.gregorian)
+		}
+
+		if name := test.date.MonthName(); name != test.monthName {
+			t.Errorf("%v.MonthName() = %v, want %v", test.date, name, test.monthName)
+		}
+	}
+
+	// Every day converts back to itself
+	for fixed := fixedFromGregorian(1900, time.January, 1); fixed < fixedFromGregorian(2100, time.January, 1); fixed++ {
+		for calendar, system := range calendarSystems {
+			year, month, day := system.fromFixed(fixed)
+			if date := (CalendarDate{calendar, year, month, day}); date.validate() != nil || system.toFixed(year, month, day) != fixed {
+				t.Fatalf("%s calendar date %v of fixed day %d does not convert back", calendar, date, fixed)
+			}
+		}
+	}
+
+	// Times keep their day in their location
+	tehran := time.FixedZone("+0330", 3*3600+30*60)
+	date, _ := ToCalendar(time.Date(2025, time.March, 20, 23, 0, 0, 0, time.UTC).In(tehran), CalendarPersian)
+	if date != (CalendarDate{CalendarPersian, 1404, 1, 1}) {
+		t.Errorf("ToCalendar(2025-03-21 02:30 +0330, persian) = %v, want 1404-01-01", date)
+	}
+	if midnight, _ := date.Time(tehran); midnight.Location() != tehran || midnight.Hour() != 0 {
+		t.Errorf("%v.Time(+0330) = %v, want midnight in +0330", date, midnight)
+	}
+}
+
+// TestCalendarDateErrors tests invalid calendar dates
+func TestCalendarDateErrors(t *testing.T) {
+	tests := []CalendarDate{
+		{CalendarHijri, 1446, 2, 30},
+		{CalendarHijri, 1446, 13, 1},
+		{CalendarHijri, 0, 1, 1},
+		{CalendarPersian, 1402, 12, 30},
+		{CalendarPersian, 3178, 1, 1},
+		{CalendarHebrew, 5785, 13, 1},
+		{CalendarHebrew, 5784, 8, 30},
+		{"julian", 2025, 1, 1},
+	}
+
+	for _, date := range tests {
+		if _, err := date.Time(nil); !errors.Is(err, ErrInvalidCalendarDate) {
+			t.Errorf("%v (%s).Time(nil) error = %v, want ErrInvalidCalendarDate", date, date.Calendar, err)
+		}
+		if name := date.MonthName(); name != "" {
+			t.Errorf("%v (%s).MonthName() = %v, want an empty string", date, date.Calendar, name)
+		}
+	}
+
+	if _, err := ToCalendar(time.Now(), "julian"); !errors.Is(err, ErrInvalidCalendarDate) {
+		t.Errorf("ToCalendar(now, \"julian\") error = %v, want ErrInvalidCalendarDate", err)
+	}
+	if _, err := ToCalendar(time.Date(500, time.January, 1, 0, 0, 0, 0, time.UTC), CalendarHijri); !errors.Is(err, ErrInvalidCalendarDate) {
+		t.Errorf("ToCalendar(0500-01-01, \"hijri\") error = %v, want ErrInvalidCalendarDate", err)
+	}
+
+	// Localized month names
+	ramadan := CalendarDate{CalendarHijri, 1446, 9, 1}
+	if name := ramadan.MonthName(WithLocale("ar")); name != "رمضان" {
+		t.Errorf("%v.MonthName(WithLocale(\"ar\")) = %v, want رمضان", ramadan, name)
+	}
+	if name := (CalendarDate{CalendarPersian, 1404, 1, 1}).MonthName(WithLocale("fa")); name != "فروردین" {
+		t.Errorf("1404-01-01.MonthName(WithLocale(\"fa\")) = %v, want فروردین", name)
+	}
+	if name := ramadan.MonthName(WithLocale("hi")); name != "Ramadan" {
+		t.Errorf("%v.MonthName(WithLocale(\"hi\")) = %v, want the English name Ramadan", ramadan, name)
+	}
+}
+
 // BenchmarkAny benchmarks the Any function
 func BenchmarkAny(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -331,3 +1325,62 @@ func BenchmarkTimezone(b *testing.B) {
 		Timezone()
 	}
 }
+
+// BenchmarkBusinessHours benchmarks the BusinessHours function
+func BenchmarkBusinessHours(b *testing.B) {
+	from := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < b.N; i++ {
+		BusinessHours(from, to)
+	}
+}
+
+// BenchmarkHolidays benchmarks the Holidays function
+func BenchmarkHolidays(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Holidays("US", 2024)
+	}
+}
+
+// BenchmarkZoned benchmarks the Zoned function
+func BenchmarkZoned(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Zoned()
+	}
+}
+
+// BenchmarkDSTEdge benchmarks the DSTEdge function
+func BenchmarkDSTEdge(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		DSTEdge()
+	}
+}
+
+// BenchmarkTimezoneInfo benchmarks the TimezoneInfo function
+func BenchmarkTimezoneInfo(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		TimezoneInfo()
+	}
+}
+
+// BenchmarkFormatted benchmarks the Formatted function
+func BenchmarkFormatted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Formatted()
+	}
+}
+
+// BenchmarkWeekdayValue benchmarks the WeekdayValue function
+func BenchmarkWeekdayValue(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		WeekdayValue(WithWeekend(false))
+	}
+}
+
+// BenchmarkHebrew benchmarks the Hebrew function
+func BenchmarkHebrew(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Hebrew()
+	}
+}
@@ -0,0 +1,122 @@
+package date
+
+import (
+	"math/big"
+	"slices"
+	"time"
+)
+
+// Day filters of WithBusinessDaysOnly and WithHolidaysOnly
+const (
+	// dayFilterBusiness keeps the working days that are not public holidays
+	dayFilterBusiness = "business"
+	// dayFilterHoliday keeps the public holidays
+	dayFilterHoliday = "holiday"
+)
+
+// holidayCalendar reports whether days are public holidays of a country, computing each year once
+type holidayCalendar struct {
+	country string
+	days    map[int]map[time.Time]bool
+}
+
+// newHolidayCalendar creates a holiday calendar for a country
+func newHolidayCalendar(country string) *holidayCalendar {
+	return &holidayCalendar{country: country, days: make(map[int]map[time.Time]bool)}
+}
+
+// isHoliday reports whether the day of a date is a public holiday, on its date or its observed date
+// Holidays of the next year are checked too, since they can be observed in the last days of the year
+func (c *holidayCalendar) isHoliday(year int, month time.Month, day int) bool {
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return c.year(year)[date] || c.year(year + 1)[date]
+}
+
+// year returns the holiday days of the holidays of a year
+func (c *holidayCalendar) year(year int) map[time.Time]bool {
+	if days, ok := c.days[year]; ok {
+		return days
+	}
+
+	days := make(map[time.Time]bool)
+	for _, holiday := range Holidays(c.country, year) {
+		days[holiday.Date] = true
+		days[holiday.Observed] = true
+	}
+	c.days[year] = days
+
+	return days
+}
+
+// allowsDay reports whether the day filter of the options allows a day
+func (o Option) allowsDay(date time.Time, holidays *holidayCalendar) bool {
+	year, month, day := date.Date()
+
+	switch o.dayFilter {
+	case dayFilterBusiness:
+		return slices.Contains(o.workDays, date.Weekday()) && !holidays.isHoliday(year, month, day)
+	case dayFilterHoliday:
+		return holidays.isHoliday(year, month, day)
+	default:
+		return true
+	}
+}
+
+// betweenDays generates a random date between two dates on a day allowed by the day filter
+// The days are those of the location of the from date, unless WithLocation is used
+// Precisions coarser than a day are reduced to a day, so that every allowed day can be drawn
+// If no day between the dates is allowed, the zero value is returned
+func betweenDays(from, to time.Time, o Option) time.Time {
+	location := from.Location()
+	if o.location != nil {
+		location = o.location
+	}
+	holidays := newHolidayCalendar(o.holidayCountry)
+	precision := min(o.precision, 24*time.Hour)
+
+	var windows [][2]time.Time
+	year, month, day := from.In(location).Date()
+	for date := time.Date(year, month, day, 0, 0, 0, 0, location); !date.After(to); date = date.AddDate(0, 0, 1) {
+		if !o.allowsDay(date, holidays) {
+			continue
+		}
+
+		// The day ends just before the next day, at the precision, which can be longer than a day shortened by DST
+		next := date.AddDate(0, 0, 1)
+		end := next.Add(-min(precision, next.Sub(date)))
+		start, end := maxTime(date, from), minTime(end, to)
+		if !end.Before(start) {
+			windows = append(windows, [2]time.Time{start, end})
+		}
+	}
+
+	if len(windows) == 0 {
+		return time.Time{}
+	}
+
+	return randomInWindows(windows, precision)
+}
+
+// randomInWindows generates a random time in one of the time windows, each including its start and end,
+// in steps of the precision from the start of each window and with a probability proportional to their lengths
+func randomInWindows(windows [][2]time.Time, precision time.Duration) time.Time {
+	// The windows can last longer than a time.Duration (about 292 years) in total
+	total := new(big.Int)
+	for _, window := range windows {
+		total.Add(total, big.NewInt(int64(window[1].Sub(window[0]))))
+	}
+
+	// Draw an offset into the windows in steps of the precision, and find its window
+	steps := new(big.Int).Quo(total, big.NewInt(int64(precision)))
+	offset := new(big.Int).Mul(randomBigN(steps), big.NewInt(int64(precision)))
+	length := new(big.Int)
+	for _, window := range windows {
+		if length.SetInt64(int64(window[1].Sub(window[0]))); offset.Cmp(length) > 0 {
+			offset.Sub(offset, length)
+			continue
+		}
+		return window[0].Add(time.Duration(offset.Int64()))
+	}
+
+	return windows[len(windows)-1][1]
+}
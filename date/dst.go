@@ -0,0 +1,128 @@
+package date
+
+import (
+	"time"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+// maxDSTAttempts is the number of time zones and years tried to find a DST transition
+const maxDSTAttempts = 100
+
+// maxZoneBounds is the number of zone bounds searched for the DST transitions of a year
+const maxZoneBounds = 64
+
+// DSTEdgeCase is a wall clock time at a daylight saving time transition of a time zone
+// A nonexistent time cannot be read with either UTC offset of the transition and an ambiguous time
+// can be read with both, so Before and After give the instants of the wall clock time read with the
+// offset before and after the transition (e.g., 01:30 EDT and 01:30 EST for an ambiguous 01:30 in New York)
+type DSTEdgeCase struct {
+	Kind       string         // DSTNonexistent or DSTAmbiguous
+	Location   *time.Location // The time zone of the transition
+	Local      string         // The wall clock time, formatted as "2006-01-02 15:04:05" with any fraction of a second
+	Transition time.Time      // The instant of the transition
+	Before     time.Time      // The wall clock time read with the UTC offset before the transition
+	After      time.Time      // The wall clock time read with the UTC offset after the transition
+}
+
+// dstTransition is a change of UTC offset of a time zone
+type dstTransition struct {
+	at     time.Time
+	before int
+	after  int
+}
+
+// DSTEdge generates a nonexistent or ambiguous wall clock time at a DST transition (see WithDSTEdgeKind)
+// The time zone is drawn like Location among those observing DST, and the year of the transition
+// is drawn in the range of Any; the wall clock time follows the precision (default is one second)
+// If no transition is found, the zero value is returned
+func DSTEdge(opts ...OptionFunc) DSTEdgeCase {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	o.dstFilter, o.dst = true, true
+	pool := filterTimezones(loadTimezones(o.locale), o)
+	if len(pool) == 0 {
+		return DSTEdgeCase{}
+	}
+
+	startYear := o.relative.AddDate(-o.years, -o.months, -o.days).Year()
+	endYear := o.relative.AddDate(o.years, o.months, o.days).Year()
+
+	for range maxDSTAttempts {
+		location := loadLocation(pool[random.IntN(len(pool))])
+		year := startYear + random.IntN(endYear-startYear+1)
+
+		transitions := dstTransitions(location, year, o.dstKind)
+		if len(transitions) == 0 {
+			continue
+		}
+
+		return newDSTEdgeCase(transitions[random.IntN(len(transitions))], location, o.precision)
+	}
+
+	return DSTEdgeCase{}
+}
+
+// dstTransitions returns the changes of UTC offset of a location during a year,
+// keeping those that create nonexistent or ambiguous times if a kind is given
+// Beyond the transitions listed in the tz database (2037), ZoneBounds can return an end that is not after
+// the time, as at the end of leap years, so the search then steps forward a day
+func dstTransitions(location *time.Location, year int, kind string) []dstTransition {
+	var transitions []dstTransition
+
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, location)
+	t := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	for range maxZoneBounds {
+		_, next := t.ZoneBounds()
+		if !next.IsZero() && !next.After(t) {
+			t = t.AddDate(0, 0, 1)
+			continue
+		}
+		if next.IsZero() || !next.Before(end) {
+			break
+		}
+
+		_, before := t.Zone()
+		_, after := next.Zone()
+		if (after > before && kind != DSTAmbiguous) || (after < before && kind != DSTNonexistent) {
+			transitions = append(transitions, dstTransition{at: next, before: before, after: after})
+		}
+		t = next
+	}
+
+	return transitions
+}
+
+// newDSTEdgeCase draws a wall clock time in the nonexistent or ambiguous times of a transition
+func newDSTEdgeCase(transition dstTransition, location *time.Location, precision time.Duration) DSTEdgeCase {
+	kind := DSTAmbiguous
+	if transition.after > transition.before {
+		kind = DSTNonexistent
+	}
+
+	// The affected wall clock times start at the transition read with the smaller offset,
+	// and last for the difference between the offsets
+	gap := time.Duration(max(transition.before, transition.after)-min(transition.before, transition.after)) * time.Second
+	start := transition.at.UTC().Add(time.Duration(min(transition.before, transition.after)) * time.Second)
+
+	var offset time.Duration
+	if steps := int64(gap / precision); steps > 0 {
+		offset = time.Duration(random.Int64N(steps)) * precision
+	}
+	wall := start.Add(offset)
+
+	return DSTEdgeCase{
+		Kind:       kind,
+		Location:   location,
+		Local:      wall.Format("2006-01-02 15:04:05.999999999"),
+		Transition: transition.at,
+		Before:     wall.Add(-time.Duration(transition.before) * time.Second).In(location),
+		After:      wall.Add(-time.Duration(transition.after) * time.Second).In(location),
+	}
+}
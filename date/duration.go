@@ -0,0 +1,34 @@
+package date
+
+import (
+	"github.com/khchehab/muzayaf/random"
+	"time"
+)
+
+// Duration generates a random duration between min and max, both included
+// The duration is a whole multiple of the precision (default is one second), such as whole minutes
+// with WithPrecision(time.Minute); if no multiple of the precision is between min and max, min is returned
+func Duration(min, max time.Duration, opts ...OptionFunc) time.Duration {
+	o := applyOptions(opts)
+
+	// Ensure min is less than max
+	if min > max {
+		min, max = max, min
+	}
+
+	// Find the multiples of the precision in the range
+	first := min / o.precision
+	if first*o.precision < min {
+		first++
+	}
+	last := max / o.precision
+	if last*o.precision > max {
+		last--
+	}
+
+	if first > last {
+		return min
+	}
+
+	return (first + time.Duration(random.Int64N(int64(last-first)+1))) * o.precision
+}
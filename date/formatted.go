@@ -0,0 +1,102 @@
+package date
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/khchehab/muzayaf/internal"
+	"github.com/khchehab/muzayaf/random"
+)
+
+// namedLayouts lists the named layouts of formatted dates
+var namedLayouts = []string{
+	LayoutISO8601Date,
+	LayoutISO8601Basic,
+	LayoutRFC3339,
+	LayoutRFC3339Nano,
+	LayoutRFC1123,
+	LayoutUnix,
+	LayoutUnixMilli,
+	LayoutMonthDayYear,
+	LayoutDayMonthYear,
+	LayoutLong,
+}
+
+// goLayouts maps the named layouts to their Go time layouts
+var goLayouts = map[string]string{
+	LayoutISO8601Date:  time.DateOnly,
+	LayoutISO8601Basic: "20060102T150405Z0700",
+	LayoutRFC3339:      time.RFC3339,
+	LayoutRFC3339Nano:  time.RFC3339Nano,
+	LayoutRFC1123:      time.RFC1123,
+	LayoutMonthDayYear: "01/02/2006",
+	LayoutDayMonthYear: "02/01/2006",
+}
+
+// Formatted generates a random date like Any and formats it in a layout drawn from the layouts
+// (see WithLayouts, default is all the named layouts)
+// The date is in the location of the relative date, unless WithLocation is used, and the long layout
+// uses the month and weekday names and the digits of the locale (default is "en")
+func Formatted(opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	layouts := o.layouts
+	if len(layouts) == 0 {
+		layouts = namedLayouts
+	}
+
+	date := Any(opts...)
+	if o.location != nil {
+		date = date.In(o.location)
+	}
+
+	return formatDate(date, layouts[random.IntN(len(layouts))], o.locale)
+}
+
+// formatDate formats a date in a named layout or a Go time layout
+func formatDate(date time.Time, layout, locale string) string {
+	switch layout {
+	case LayoutUnix:
+		return strconv.FormatInt(date.Unix(), 10)
+	case LayoutUnixMilli:
+		return strconv.FormatInt(date.UnixMilli(), 10)
+	case LayoutLong:
+		return formatLong(date, locale)
+	}
+
+	if goLayout, exists := goLayouts[layout]; exists {
+		layout = goLayout
+	}
+
+	return date.Format(layout)
+}
+
+// formatLong formats a date in the long layout of a locale
+// The pattern of the locale places the {weekday}, {day}, {month} and {year} of the date,
+// and the day and the year are written with the digits of the numbering system of the locale
+func formatLong(date time.Time, locale string) string {
+	pattern := fallbackValues[locale]["long"]
+	system := "latn"
+	if data, err := internal.LoadJsonFile("date", locale, "formats.json"); err == nil {
+		if long := internal.GetString(data, "long"); long != "" {
+			pattern = long
+		}
+		if numberingSystem := internal.GetString(data, "numbering_system"); numberingSystem != "" {
+			system = numberingSystem
+		}
+	}
+
+	return strings.NewReplacer(
+		"{weekday}", WeekdayName(date.Weekday(), WithLocale(locale)),
+		"{day}", internal.LocalizeDigits(strconv.Itoa(date.Day()), system),
+		"{month}", MonthName(date.Month(), WithLocale(locale)),
+		"{year}", internal.LocalizeDigits(strconv.Itoa(date.Year()), system),
+	).Replace(pattern)
+}
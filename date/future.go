@@ -6,7 +6,7 @@ import (
 
 // Future generates a random date in the future
 // It can use a relative date if specified in the options (default is today)
-// It can also specify how many years, months, or days to go forward
+// It can also specify how many years, months, or days to go forward, the precision and the day filters (see Between)
 func Future(opts ...OptionFunc) time.Time {
 	o := applyOptions(opts)
 
@@ -22,5 +22,5 @@ func Future(opts ...OptionFunc) time.Time {
 	}
 
 	// Generate a random date between the minimum and maximum dates
-	return Between(minDate, maxDate)
+	return Between(minDate, maxDate, opts...)
 }
@@ -0,0 +1,117 @@
+package date
+
+// hebrewEpoch is the fixed day number of 1 Tishrei 1 AM (October 7, 3761 BCE in the Julian calendar)
+const hebrewEpoch = -1373427
+
+// hebrewCalendar is the Hebrew calendar, with the algorithms of Calendrical Calculations (Reingold and Dershowitz)
+// Leap years add Adar II, and Heshvan and Kislev have 29 or 30 days so that the year starts on an allowed weekday
+var hebrewCalendar = calendarSystem{
+	minYear:     1,
+	maxYear:     9999,
+	months:      hebrewMonths,
+	daysInMonth: hebrewMonthDays,
+	toFixed:     fixedFromHebrew,
+	fromFixed:   hebrewFromFixed,
+}
+
+// hebrewLeapYear reports whether a year of the Hebrew calendar is a leap year
+// (years 3, 6, 8, 11, 14, 17 and 19 of each 19-year cycle)
+func hebrewLeapYear(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// hebrewMonths returns the number of months of a year of the Hebrew calendar
+func hebrewMonths(year int) int {
+	if hebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewElapsedDays returns the number of days from the epoch to the molad of Tishrei of a year,
+// delayed by a day when it falls on a Sunday, Wednesday or Friday
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// hebrewNewYear returns the fixed day number of 1 Tishrei of a year
+// The new year is delayed when the year would otherwise have 356 days or the previous year 382 days
+func hebrewNewYear(year int) int {
+	previous, current, next := hebrewElapsedDays(year-1), hebrewElapsedDays(year), hebrewElapsedDays(year+1)
+
+	correction := 0
+	switch {
+	case next-current == 356:
+		correction = 2
+	case current-previous == 382:
+		correction = 1
+	}
+
+	return hebrewEpoch + current + correction
+}
+
+// hebrewMonthDays returns the number of days of a month of the Hebrew calendar
+func hebrewMonthDays(year, month int) int {
+	yearDays := hebrewNewYear(year+1) - hebrewNewYear(year)
+
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !hebrewLeapYear(year):
+		return 29
+	case month == 8 && yearDays%10 != 5:
+		// Heshvan has 30 days in complete years (355 or 385 days)
+		return 29
+	case month == 9 && yearDays%10 == 3:
+		// Kislev has 29 days in deficient years (353 or 383 days)
+		return 29
+	default:
+		return 30
+	}
+}
+
+// fixedFromHebrew returns the fixed day number of a date of the Hebrew calendar
+func fixedFromHebrew(year, month, day int) int {
+	fixed := hebrewNewYear(year) + day - 1
+
+	// The year starts with Tishrei (7), and the months from Nisan (1) follow the last month of the year
+	if month < 7 {
+		for m := 7; m <= hebrewMonths(year); m++ {
+			fixed += hebrewMonthDays(year, m)
+		}
+		for m := 1; m < month; m++ {
+			fixed += hebrewMonthDays(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			fixed += hebrewMonthDays(year, m)
+		}
+	}
+
+	return fixed
+}
+
+// hebrewFromFixed returns the date of the Hebrew calendar of a fixed day number
+func hebrewFromFixed(fixed int) (year, month, day int) {
+	// Start from an approximation of the year, using the average length of a year
+	year = floorDiv((fixed-hebrewEpoch)*98496, 35975351)
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+
+	month = 1
+	if fixed < fixedFromHebrew(year, 1, 1) {
+		month = 7
+	}
+	for fixed > fixedFromHebrew(year, month, hebrewMonthDays(year, month)) {
+		month++
+	}
+
+	return year, month, fixed - fixedFromHebrew(year, month, 1) + 1
+}
@@ -0,0 +1,41 @@
+package date
+
+// hijriEpoch is the fixed day number of 1 Muharram 1 AH (July 16, 622 in the Julian calendar)
+const hijriEpoch = 227015
+
+// hijriCalendar is the tabular Islamic calendar, with the algorithms of Calendrical Calculations
+// (Reingold and Dershowitz): months alternate between 30 and 29 days, and Dhu al-Hijjah has 30 days in leap years
+var hijriCalendar = calendarSystem{
+	minYear: 1,
+	maxYear: 9999,
+	months: func(year int) int {
+		return 12
+	},
+	daysInMonth: func(year, month int) int {
+		if month%2 == 1 || (month == 12 && hijriLeapYear(year)) {
+			return 30
+		}
+		return 29
+	},
+	toFixed:   fixedFromHijri,
+	fromFixed: hijriFromFixed,
+}
+
+// hijriLeapYear reports whether a year of the tabular Islamic calendar is a leap year
+// (years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29 of each 30-year cycle)
+func hijriLeapYear(year int) bool {
+	return floorMod(14+11*year, 30) < 11
+}
+
+// fixedFromHijri returns the fixed day number of a date of the tabular Islamic calendar
+func fixedFromHijri(year, month, day int) int {
+	return hijriEpoch - 1 + (year-1)*354 + floorDiv(3+11*year, 30) + 29*(month-1) + floorDiv(6*month-1, 11) + day
+}
+
+// hijriFromFixed returns the date of the tabular Islamic calendar of a fixed day number
+func hijriFromFixed(fixed int) (year, month, day int) {
+	year = floorDiv(30*(fixed-hijriEpoch)+10646, 10631)
+	month = floorDiv(11*(fixed-fixedFromHijri(year, 1, 1))+330, 325)
+	day = fixed - fixedFromHijri(year, month, 1) + 1
+	return year, month, day
+}
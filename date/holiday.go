@@ -0,0 +1,247 @@
+package date
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/khchehab/muzayaf/internal"
+	"github.com/khchehab/muzayaf/random"
+)
+
+// PublicHoliday represents a public holiday of a country in a year
+// The dates are at midnight UTC, and the observed date is the day off when the holiday is moved off
+// a weekend (e.g., to the Friday before or the Monday after), or the date of the holiday otherwise
+type PublicHoliday struct {
+	Name      string
+	LocalName string
+	Date      time.Time
+	Observed  time.Time
+}
+
+// holidayRule is the rule of a holiday as stored in a holiday calendar
+type holidayRule struct {
+	name      string
+	localName string
+	month     time.Month
+	day       int
+	weekday   time.Weekday
+	week      int
+	easter    int
+	kind      string
+	observed  string
+	from      int
+	until     int
+}
+
+// Kinds of holiday rules
+const (
+	// ruleFixed is a holiday on a fixed date (e.g., December 25)
+	ruleFixed = "fixed"
+	// ruleWeekday is a holiday on the nth weekday of a month (e.g., the last Monday of May)
+	ruleWeekday = "weekday"
+	// ruleEaster is a holiday a number of days from Easter Sunday (e.g., 2 days before for Good Friday)
+	ruleEaster = "easter"
+)
+
+// Observance rules of holidays that fall on a weekend
+const (
+	// observedNearestWeekday moves Saturday holidays to Friday and Sunday holidays to Monday
+	observedNearestWeekday = "nearest_weekday"
+	// observedNextWeekday moves weekend holidays to the next weekday that is not already a day off
+	observedNextWeekday = "next_weekday"
+)
+
+// weekdayNames maps the English weekday names of holiday calendars to weekdays
+var weekdayNames = map[string]time.Weekday{
+	"sunday":    time.Sunday,
+	"monday":    time.Monday,
+	"tuesday":   time.Tuesday,
+	"wednesday": time.Wednesday,
+	"thursday":  time.Thursday,
+	"friday":    time.Friday,
+	"saturday":  time.Saturday,
+}
+
+// Holidays returns the public holidays of a country in a year, sorted by date
+// The country is an ISO 3166-1 alpha-2 code (e.g., "US", "GB", "DE" or "FR"), and the holidays are
+// the national ones, computed from fixed dates, nth weekday rules and Easter (Western, Gregorian)
+// An unknown country returns no holidays
+func Holidays(country string, year int) []PublicHoliday {
+	type scheduledHoliday struct {
+		holiday  PublicHoliday
+		observed string
+	}
+
+	var scheduled []scheduledHoliday
+	for _, rule := range loadHolidayRules(country) {
+		if (rule.from != 0 && year < rule.from) || (rule.until != 0 && year > rule.until) {
+			continue
+		}
+
+		date, ok := rule.date(year)
+		if !ok {
+			continue
+		}
+
+		localName := rule.localName
+		if localName == "" {
+			localName = rule.name
+		}
+		scheduled = append(scheduled, scheduledHoliday{
+			holiday:  PublicHoliday{Name: rule.name, LocalName: localName, Date: date, Observed: date},
+			observed: rule.observed,
+		})
+	}
+
+	slices.SortStableFunc(scheduled, func(a, b scheduledHoliday) int {
+		return a.holiday.Date.Compare(b.holiday.Date)
+	})
+
+	// Move the weekend holidays to their observed dates, in date order so that
+	// holidays moved to the next weekday do not take the day off of another holiday
+	taken := make(map[time.Time]bool, len(scheduled))
+	for _, s := range scheduled {
+		taken[s.holiday.Date] = true
+	}
+
+	holidays := make([]PublicHoliday, len(scheduled))
+	for i, s := range scheduled {
+		holidays[i] = s.holiday
+		holidays[i].Observed = observedDate(s.holiday.Date, s.observed, taken)
+		taken[holidays[i].Observed] = true
+	}
+
+	return holidays
+}
+
+// Holiday returns a random public holiday of a country in a year
+// It returns the zero PublicHoliday if the country is unknown
+func Holiday(country string, year int) PublicHoliday {
+	holidays := Holidays(country, year)
+	if len(holidays) == 0 {
+		return PublicHoliday{}
+	}
+
+	return holidays[random.IntN(len(holidays))]
+}
+
+// loadHolidayRules loads the holiday calendar of a country from the base locale
+// Country codes that are not two letters return no rules
+func loadHolidayRules(country string) []holidayRule {
+	if len(country) != 2 || strings.Trim(strings.ToLower(country), "abcdefghijklmnopqrstuvwxyz") != "" {
+		return nil
+	}
+
+	data, err := internal.LoadJsonFile("date", "base", "holidays/"+strings.ToLower(country)+".json")
+	if err != nil {
+		return nil
+	}
+
+	var rules []holidayRule
+	for _, entry := range internal.GetMapSlice(data, "holidays") {
+		rule := holidayRule{
+			name:      internal.GetString(entry, "name"),
+			localName: internal.GetString(entry, "local_name"),
+			month:     time.Month(internal.GetInt(entry, "month")),
+			day:       internal.GetInt(entry, "day"),
+			week:      internal.GetInt(entry, "week"),
+			easter:    internal.GetInt(entry, "easter"),
+			observed:  internal.GetString(entry, "observed"),
+			from:      internal.GetInt(entry, "from"),
+			until:     internal.GetInt(entry, "until"),
+		}
+
+		weekday, hasWeekday := weekdayNames[strings.ToLower(internal.GetString(entry, "weekday"))]
+		_, hasEaster := entry["easter"]
+		switch {
+		case hasEaster:
+			rule.kind = ruleEaster
+		case hasWeekday && rule.week != 0 && rule.month >= time.January && rule.month <= time.December:
+			rule.kind, rule.weekday = ruleWeekday, weekday
+		case rule.month >= time.January && rule.month <= time.December && rule.day >= 1:
+			rule.kind = ruleFixed
+		default:
+			continue
+		}
+
+		if rule.name != "" {
+			rules = append(rules, rule)
+		}
+	}
+
+	return rules
+}
+
+// date returns the date of the holiday in a year, and false if the holiday does not exist that year
+// (e.g., a fixed date of February 29 in a common year)
+func (r holidayRule) date(year int) (time.Time, bool) {
+	switch r.kind {
+	case ruleEaster:
+		return easterSunday(year).AddDate(0, 0, r.easter), true
+	case ruleWeekday:
+		return nthWeekday(year, r.month, r.weekday, r.week)
+	default:
+		date := time.Date(year, r.month, r.day, 0, 0, 0, 0, time.UTC)
+		return date, date.Month() == r.month
+	}
+}
+
+// nthWeekday returns the nth weekday of a month (e.g., the 3rd Monday), counting from the end of
+// the month for negative weeks (-1 is the last one), and false if the month has no such weekday
+func nthWeekday(year int, month time.Month, weekday time.Weekday, week int) (time.Time, bool) {
+	var date time.Time
+	if week > 0 {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+		date = first.AddDate(0, 0, (int(weekday)-int(first.Weekday())+7)%7+(week-1)*7)
+	} else {
+		last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC)
+		date = last.AddDate(0, 0, -((int(last.Weekday())-int(weekday)+7)%7)+(week+1)*7)
+	}
+
+	return date, date.Month() == month
+}
+
+// easterSunday returns the date of Easter Sunday in the Gregorian calendar
+// with the anonymous Gregorian algorithm (Meeus, Jones and Butcher)
+func easterSunday(year int) time.Time {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+// observedDate returns the day off of a holiday with an observance rule
+// Holidays on weekdays, or without an observance rule, are observed on their date
+func observedDate(date time.Time, observed string, taken map[time.Time]bool) time.Time {
+	weekend := date.Weekday() == time.Saturday || date.Weekday() == time.Sunday
+	if !weekend {
+		return date
+	}
+
+	switch observed {
+	case observedNearestWeekday:
+		if date.Weekday() == time.Saturday {
+			return date.AddDate(0, 0, -1)
+		}
+		return date.AddDate(0, 0, 1)
+	case observedNextWeekday:
+		for {
+			date = date.AddDate(0, 0, 1)
+			if date.Weekday() != time.Saturday && date.Weekday() != time.Sunday && !taken[date] {
+				return date
+			}
+		}
+	}
+
+	return date
+}
@@ -0,0 +1,143 @@
+package date
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/khchehab/muzayaf/random"
+)
+
+var (
+	// locationCache stores the loaded locations of the timezones, nil for those that cannot be loaded
+	locationCache = make(map[string]*time.Location)
+	// locationCacheSync provides thread-safe access to the locationCache
+	locationCacheSync sync.RWMutex
+)
+
+// Location generates a random time zone as a *time.Location
+// The time zones are those of Timezone that can be loaded from the IANA time zone database of the system
+// (see time.LoadLocation), filtered by WithCountries, WithContinents, WithUTCOffsetRange and WithDST
+// If no time zone matches the filters, UTC is returned
+func Location(opts ...OptionFunc) *time.Location {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	pool := filterTimezones(loadTimezones(o.locale), o)
+	if len(pool) == 0 {
+		return time.UTC
+	}
+
+	return loadLocation(pool[random.IntN(len(pool))])
+}
+
+// Zoned generates a random date like Any, in a random time zone drawn like Location,
+// or in the time zone set with WithLocation
+// Day filters such as WithBusinessDaysOnly use the days of that time zone
+func Zoned(opts ...OptionFunc) time.Time {
+	location := zonedLocation(applyOptions(opts), opts)
+	return Any(append(opts, WithLocation(location))...).In(location)
+}
+
+// ZonedBetween generates a random date between two dates like Between, in a random time zone
+// drawn like Location, or in the time zone set with WithLocation
+// Day filters such as WithBusinessDaysOnly use the days of that time zone
+func ZonedBetween(from, to time.Time, opts ...OptionFunc) time.Time {
+	location := zonedLocation(applyOptions(opts), opts)
+	return Between(from, to, append(opts, WithLocation(location))...).In(location)
+}
+
+// zonedLocation returns the location set with WithLocation, or a random location
+func zonedLocation(o Option, opts []OptionFunc) *time.Location {
+	if o.location != nil {
+		return o.location
+	}
+	return Location(opts...)
+}
+
+// hasTimezoneFilters reports whether the options filter the time zones
+func (o Option) hasTimezoneFilters() bool {
+	return len(o.countries) > 0 || o.hasLocationFilters()
+}
+
+// hasLocationFilters reports whether the options filter the time zones by their names or rules
+func (o Option) hasLocationFilters() bool {
+	return len(o.continents) > 0 || o.offsetFilter || o.dstFilter
+}
+
+// filterTimezones returns the time zones that can be loaded and match the time zone filters
+// The countries of the time zones are those of the time zone metadata (see TimezoneInfo)
+func filterTimezones(pool []string, o Option) []string {
+	var countries map[string]string
+	if len(o.countries) > 0 {
+		countries = make(map[string]string)
+		for _, zone := range loadTimezoneMetadata(o.locale) {
+			countries[zone.Name] = zone.Country
+		}
+	}
+
+	var timezones []string
+	for _, name := range pool {
+		if countries != nil && !containsFold(o.countries, countries[name]) {
+			continue
+		}
+		if location := loadLocation(name); location != nil && o.matchesTimezone(name, location) {
+			timezones = append(timezones, name)
+		}
+	}
+	return timezones
+}
+
+// matchesTimezone reports whether a time zone matches the continent, UTC offset and DST filters
+func (o Option) matchesTimezone(name string, location *time.Location) bool {
+	if area, _, _ := strings.Cut(name, "/"); len(o.continents) > 0 && !containsFold(o.continents, area) {
+		return false
+	}
+
+	if o.offsetFilter {
+		_, seconds := o.relative.In(location).Zone()
+		if offset := time.Duration(seconds) * time.Second; offset < o.offsetMin || offset > o.offsetMax {
+			return false
+		}
+	}
+
+	if o.dstFilter && observesDST(location, o.relative.Year()) != o.dst {
+		return false
+	}
+
+	return true
+}
+
+// observesDST reports whether a location has a different UTC offset in January and July of a year
+func observesDST(location *time.Location, year int) bool {
+	_, january := time.Date(year, time.January, 1, 12, 0, 0, 0, location).Zone()
+	_, july := time.Date(year, time.July, 1, 12, 0, 0, 0, location).Zone()
+	return january != july
+}
+
+// loadLocation loads the location of a time zone, caching the result
+// It returns nil if the time zone cannot be loaded
+func loadLocation(name string) *time.Location {
+	locationCacheSync.RLock()
+	location, cached := locationCache[name]
+	locationCacheSync.RUnlock()
+	if cached {
+		return location
+	}
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		location = nil
+	}
+
+	locationCacheSync.Lock()
+	locationCache[name] = location
+	locationCacheSync.Unlock()
+
+	return location
+}
@@ -1,12 +1,15 @@
 package date
 
 import (
+	"time"
+
 	"github.com/khchehab/muzayaf/internal"
 	"github.com/khchehab/muzayaf/random"
 )
 
 // Month returns a random month name
-// It can use a specific locale if specified in the options (default is "en")
+// It can use a specific locale if specified in the options (default is "en"),
+// and abbreviated or narrow names with WithNameWidth (e.g., "Sep" or "S")
 func Month(opts ...OptionFunc) string {
 	o := applyOptions(opts)
 
@@ -22,10 +25,39 @@ func Month(opts ...OptionFunc) string {
 		return fallbackValues[o.locale]["month"]
 	}
 
-	pool := internal.GetStringSlice(data, "months")
+	pool := internal.GetStringSlice(data, nameKey("months", o.nameWidth))
 	if len(pool) == 0 {
 		return fallbackValues[o.locale]["month"]
 	}
 
 	return pool[random.IntN(len(pool))]
 }
+
+// MonthValue returns a random month as a time.Month
+// It takes the options of WeekdayValue for symmetry, though none of them restricts the months yet
+func MonthValue(opts ...OptionFunc) time.Month {
+	return time.Month(random.IntN(12) + 1)
+}
+
+// MonthName returns the name of a month in the locale (default is "en") and the width (default is NameWide)
+// of the options, or its English name if the locale has no names in that width
+// Months out of range return an empty string
+func MonthName(month time.Month, opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	if month < time.January || month > time.December {
+		return ""
+	}
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	if names := loadNames(o.locale, "months.json", "months", o.nameWidth, 12); names != nil {
+		return names[month-1]
+	}
+
+	return month.String()
+}
@@ -0,0 +1,30 @@
+package date
+
+import (
+	"github.com/khchehab/muzayaf/internal"
+)
+
+// nameKey returns the key of the month or weekday names of a width (e.g., "months_abbreviated")
+// The wide names use the key itself
+func nameKey(key, width string) string {
+	if width == NameWide {
+		return key
+	}
+	return key + "_" + width
+}
+
+// loadNames loads the month or weekday names of a locale in a width
+// It returns nil if the names are missing or their number is not the expected one
+func loadNames(locale, fileName, key, width string, count int) []string {
+	data, err := internal.LoadJsonFile("date", locale, fileName)
+	if err != nil {
+		return nil
+	}
+
+	names := internal.GetStringSlice(data, nameKey(key, width))
+	if len(names) != count {
+		return nil
+	}
+
+	return names
+}
@@ -1,14 +1,104 @@
 package date
 
-import "time"
+import (
+	"slices"
+	"time"
+)
+
+// DST edge case kinds
+const (
+	// DSTNonexistent is a wall clock time skipped when the clocks go forward (e.g., 02:30 on a spring-forward day)
+	DSTNonexistent = "nonexistent"
+	// DSTAmbiguous is a wall clock time that occurs twice when the clocks go back (e.g., 01:30 on a fall-back day)
+	DSTAmbiguous = "ambiguous"
+)
+
+// Calendar systems
+const (
+	// CalendarHijri is the tabular Islamic (Hijri) calendar, the arithmetic form of the lunar calendar
+	// with 11 leap years in each 30-year cycle and the civil epoch of July 16, 622
+	CalendarHijri = "hijri"
+	// CalendarPersian is the Solar Hijri (Persian) calendar, as used in Iran and Afghanistan
+	CalendarPersian = "persian"
+	// CalendarHebrew is the Hebrew calendar, a lunisolar calendar with 7 leap years in each 19-year cycle
+	CalendarHebrew = "hebrew"
+)
+
+// Widths of month and weekday names
+const (
+	// NameWide is the full name (e.g., "September" or "Wednesday")
+	NameWide = "wide"
+	// NameAbbreviated is the abbreviated name (e.g., "Sep" or "Wed")
+	NameAbbreviated = "abbreviated"
+	// NameNarrow is the narrow name, usually one letter and not unique (e.g., "S" or "W")
+	NameNarrow = "narrow"
+)
+
+// Layouts of formatted dates
+const (
+	// LayoutISO8601Date is the ISO 8601 calendar date (e.g., "2025-03-04")
+	LayoutISO8601Date = "iso8601-date"
+	// LayoutISO8601Basic is the ISO 8601 basic format without separators (e.g., "20250304T143005+0100")
+	LayoutISO8601Basic = "iso8601-basic"
+	// LayoutRFC3339 is the RFC 3339 date and time, the common ISO 8601 profile (e.g., "2025-03-04T14:30:05+01:00")
+	LayoutRFC3339 = "rfc3339"
+	// LayoutRFC3339Nano is the RFC 3339 date and time with fractions of a second (e.g., "2025-03-04T14:30:05.123Z")
+	LayoutRFC3339Nano = "rfc3339-nano"
+	// LayoutRFC1123 is the RFC 1123 date and time of HTTP and email headers (e.g., "Tue, 04 Mar 2025 14:30:05 CET")
+	LayoutRFC1123 = "rfc1123"
+	// LayoutUnix is the Unix time in seconds (e.g., "1741095005")
+	LayoutUnix = "unix"
+	// LayoutUnixMilli is the Unix time in milliseconds (e.g., "1741095005123")
+	LayoutUnixMilli = "unix-milli"
+	// LayoutMonthDayYear is the numeric month/day/year date used in the US (e.g., "03/04/2025" for March 4)
+	LayoutMonthDayYear = "mdy"
+	// LayoutDayMonthYear is the numeric day/month/year date used in most of the world (e.g., "04/03/2025" for March 4)
+	LayoutDayMonthYear = "dmy"
+	// LayoutLong is the long date of the locale, with the weekday and month names and the digits of the locale
+	// (e.g., "Tuesday, 4 March 2025" in "en" or "الثلاثاء ٤ مارس ٢٠٢٥" in "ar")
+	LayoutLong = "long"
+)
 
 // Option struct holds configuration for date data generation
 type Option struct {
-	locale   string
-	relative time.Time
-	years    int
-	months   int
-	days     int
+	locale    string
+	relative  time.Time
+	years     int
+	months    int
+	days      int
+	precision time.Duration
+	location  *time.Location
+	layouts   []string
+	nameWidth string
+
+	// Weekday options
+	weekendFilter bool
+	weekend       bool
+
+	// Time zone options
+	countries    []string
+	continents   []string
+	offsetFilter bool
+	offsetMin    time.Duration
+	offsetMax    time.Duration
+	dstFilter    bool
+	dst          bool
+	dstKind      string
+
+	// Time of day options
+	hourMin   int
+	hourMax   int
+	minuteMin int
+	minuteMax int
+
+	// Day filter options
+	dayFilter      string
+	holidayCountry string
+
+	// Business hours options
+	workStart time.Duration
+	workEnd   time.Duration
+	workDays  []time.Weekday
 }
 
 // OptionFunc is a function that modifies an Option
@@ -17,11 +107,25 @@ type OptionFunc func(*Option)
 // defaultOption returns the default configuration
 func defaultOption() Option {
 	return Option{
-		locale:   "en",
-		relative: time.Now(),
-		years:    100,
-		months:   0,
-		days:     0,
+		locale:    "en",
+		relative:  time.Now(),
+		years:     100,
+		months:    0,
+		days:      0,
+		precision: time.Second,
+		location:  nil,
+		nameWidth: NameWide,
+
+		// Time of day defaults
+		hourMin:   0,
+		hourMax:   23,
+		minuteMin: 0,
+		minuteMax: 59,
+
+		// Business hours defaults
+		workStart: 9 * time.Hour,
+		workEnd:   17 * time.Hour,
+		workDays:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
 	}
 }
 
@@ -68,3 +172,176 @@ func WithDays(days int) OptionFunc {
 		o.days = days
 	}
 }
+
+// WithPrecision sets the resolution of generated dates, times and durations (default is one second)
+// Use time.Millisecond, time.Microsecond or time.Nanosecond for sub-second precision, or a coarser
+// duration such as time.Minute or 15*time.Minute; non-positive values are ignored
+func WithPrecision(precision time.Duration) OptionFunc {
+	return func(o *Option) {
+		if precision > 0 {
+			o.precision = precision
+		}
+	}
+}
+
+// WithLocation sets the time zone of generated zoned times, times of day and business hours
+// By default zoned times use a random time zone (see Location), times of day use the location of the relative date, and business hours that of the from date
+func WithLocation(location *time.Location) OptionFunc {
+	return func(o *Option) {
+		o.location = location
+	}
+}
+
+// WithCountries restricts time zones to those of the given countries, as ISO 3166-1 alpha-2 codes
+// (e.g., "DE" or "us"); the countries of the time zones are those of TimezoneInfo
+func WithCountries(countries ...string) OptionFunc {
+	return func(o *Option) {
+		o.countries = countries
+	}
+}
+
+// WithContinents restricts time zones to those of the given IANA areas (e.g., "Europe" or "America"),
+// the first part of their names; the areas are case insensitive
+func WithContinents(continents ...string) OptionFunc {
+	return func(o *Option) {
+		o.continents = continents
+	}
+}
+
+// WithUTCOffsetRange restricts time zones to those whose UTC offset at the relative date
+// is from min to max, both included (e.g., -5*time.Hour and -3*time.Hour)
+// If min is greater than max they are swapped
+func WithUTCOffsetRange(min, max time.Duration) OptionFunc {
+	return func(o *Option) {
+		if min > max {
+			min, max = max, min
+		}
+		o.offsetFilter = true
+		o.offsetMin = min
+		o.offsetMax = max
+	}
+}
+
+// WithDST restricts time zones to those that observe daylight saving time in the year
+// of the relative date if observes is true, or to those that do not if it is false
+func WithDST(observes bool) OptionFunc {
+	return func(o *Option) {
+		o.dstFilter = true
+		o.dst = observes
+	}
+}
+
+// WithDSTEdgeKind sets the kind of DST edge cases generated by DSTEdge:
+// DSTNonexistent or DSTAmbiguous (default is either); other values are ignored
+func WithDSTEdgeKind(kind string) OptionFunc {
+	return func(o *Option) {
+		if kind == DSTNonexistent || kind == DSTAmbiguous {
+			o.dstKind = kind
+		}
+	}
+}
+
+// WithNameWidth sets the width of month and weekday names: NameWide (default), NameAbbreviated or NameNarrow
+// Other values are ignored
+func WithNameWidth(width string) OptionFunc {
+	return func(o *Option) {
+		if width == NameWide || width == NameAbbreviated || width == NameNarrow {
+			o.nameWidth = width
+		}
+	}
+}
+
+// WithWeekend restricts weekdays to the weekend days of the locale if weekend is true,
+// or to the other days if it is false (see Weekend)
+func WithWeekend(weekend bool) OptionFunc {
+	return func(o *Option) {
+		o.weekendFilter = true
+		o.weekend = weekend
+	}
+}
+
+// WithLayouts sets the layouts that formatted dates are drawn from (default is all the named layouts)
+// Each layout is one of the Layout constants or a Go time layout (e.g., "02 Jan 06 15:04 MST")
+func WithLayouts(layouts ...string) OptionFunc {
+	return func(o *Option) {
+		o.layouts = layouts
+	}
+}
+
+// WithHourRange sets the range of hours (0-23) of generated times of day
+// If min is greater than max the range wraps around midnight (e.g., 22 to 5 for night times)
+func WithHourRange(min, max int) OptionFunc {
+	return func(o *Option) {
+		o.hourMin = clampInt(min, 0, 23)
+		o.hourMax = clampInt(max, 0, 23)
+	}
+}
+
+// WithMinuteRange sets the range of minutes (0-59) within each hour of generated times of day
+// If min is greater than max the range wraps around the hour (e.g., 45 to 14 for times around the hour)
+func WithMinuteRange(min, max int) OptionFunc {
+	return func(o *Option) {
+		o.minuteMin = clampInt(min, 0, 59)
+		o.minuteMax = clampInt(max, 0, 59)
+	}
+}
+
+// WithWorkingHours sets the working hours of business hours, as offsets from midnight
+// (e.g., 9*time.Hour and 17*time.Hour+30*time.Minute for 09:00 to 17:30, the default being 09:00 to 17:00)
+// If end is not after start the working hours end on the next day (e.g., 22:00 to 06:00 for night shifts)
+// Values outside 0 to 24 hours are ignored
+func WithWorkingHours(start, end time.Duration) OptionFunc {
+	return func(o *Option) {
+		if start < 0 || start > 24*time.Hour || end < 0 || end > 24*time.Hour {
+			return
+		}
+		o.workStart = start
+		o.workEnd = end
+	}
+}
+
+// WithWorkingDays sets the weekdays of business hours and business days (default is Monday to Friday)
+// Invalid weekdays are ignored, and so is the option if no valid weekdays are given
+func WithWorkingDays(days ...time.Weekday) OptionFunc {
+	return func(o *Option) {
+		var workDays []time.Weekday
+		for _, day := range days {
+			if day >= time.Sunday && day <= time.Saturday && !slices.Contains(workDays, day) {
+				workDays = append(workDays, day)
+			}
+		}
+		if len(workDays) > 0 {
+			o.workDays = workDays
+		}
+	}
+}
+
+// WithBusinessDaysOnly restricts generated dates to business days: working days (see WithWorkingDays)
+// that are not public holidays of the country, on their date or their observed date (see Holidays)
+// With an unknown country only the working days are used; it replaces WithHolidaysOnly
+func WithBusinessDaysOnly(country string) OptionFunc {
+	return func(o *Option) {
+		o.dayFilter = dayFilterBusiness
+		o.holidayCountry = country
+	}
+}
+
+// WithHolidaysOnly restricts generated dates to the public holidays of the country,
+// on their date or their observed date (see Holidays); it replaces WithBusinessDaysOnly
+func WithHolidaysOnly(country string) OptionFunc {
+	return func(o *Option) {
+		o.dayFilter = dayFilterHoliday
+		o.holidayCountry = country
+	}
+}
+
+// clampInt clamps a value to the range [min, max]
+func clampInt(value, min, max int) int {
+	if value < min {
+		return min
+	}
+	if value > max {
+		return max
+	}
+	return value
+}
@@ -0,0 +1,113 @@
+package date
+
+import (
+	"time"
+)
+
+// persianBreaks are the years of the Solar Hijri calendar where its 33-year leap cycles are broken,
+// so that the years start on the day of the March equinox as observed in Iran (Borkowski's algorithm)
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// persianCalendar is the Solar Hijri calendar: the first six months have 31 days, the next five 30 days,
+// and Esfand has 29 days, or 30 in leap years
+// It follows the official calendar from 1 to 3177 AP
+var persianCalendar = calendarSystem{
+	minYear: 1,
+	maxYear: persianBreaks[len(persianBreaks)-1] - 1,
+	months: func(year int) int {
+		return 12
+	},
+	daysInMonth: func(year, month int) int {
+		switch {
+		case month <= 6:
+			return 31
+		case month <= 11:
+			return 30
+		case persianLeapYear(year):
+			return 30
+		default:
+			return 29
+		}
+	},
+	toFixed:   fixedFromPersian,
+	fromFixed: persianFromFixed,
+}
+
+// persianYear returns the position of a year in its leap cycle (0 for leap years),
+// the Gregorian year of its first day and the day of March of its first day
+func persianYear(year int) (leap, gregorianYear, march int) {
+	gregorianYear = year + 621
+
+	// Count the leap years before the year, by whole cycles between the breaks
+	leapYears := -14
+	previous, jump := persianBreaks[0], 0
+	for _, next := range persianBreaks[1:] {
+		jump = next - previous
+		if year < next {
+			break
+		}
+		leapYears += jump/33*8 + jump%33/4
+		previous = next
+	}
+
+	n := year - previous
+	leapYears += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapYears++
+	}
+
+	// The first day of the year is in March, shifted by the Solar Hijri and the Gregorian leap years
+	gregorianLeapYears := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+	march = 20 + leapYears - gregorianLeapYears
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+
+	return leap, gregorianYear, march
+}
+
+// persianLeapYear reports whether a year of the Solar Hijri calendar is a leap year
+func persianLeapYear(year int) bool {
+	leap, _, _ := persianYear(year)
+	return leap == 0
+}
+
+// fixedFromPersian returns the fixed day number of a date of the Solar Hijri calendar
+func fixedFromPersian(year, month, day int) int {
+	_, gregorianYear, march := persianYear(year)
+	return fixedFromGregorian(gregorianYear, time.March, march) + (month-1)*31 - month/7*(month-7) + day - 1
+}
+
+// persianFromFixed returns the date of the Solar Hijri calendar of a fixed day number
+// Days out of the range of the breaks return a year out of the range of the calendar
+func persianFromFixed(fixed int) (year, month, day int) {
+	gregorianYear := time.Unix(int64(fixed-unixEpochFixed)*86400, 0).UTC().Year()
+	year = gregorianYear - 621
+	if year <= persianBreaks[0] || year >= persianBreaks[len(persianBreaks)-1] {
+		return year, 1, 1
+	}
+
+	leap, _, march := persianYear(year)
+	days := fixed - fixedFromGregorian(gregorianYear, time.March, march)
+	if days >= 0 {
+		// The first six months have 31 days
+		if days <= 185 {
+			return year, 1 + days/31, days%31 + 1
+		}
+		days -= 186
+	} else {
+		// The day is in the last months of the previous year
+		year--
+		days += 179
+		if leap == 1 {
+			days++
+		}
+	}
+
+	return year, 7 + days/30, days%30 + 1
+}
@@ -0,0 +1,66 @@
+package date
+
+import (
+	"github.com/khchehab/muzayaf/random"
+	"time"
+)
+
+// TimeOfDay generates a random time on the day of the relative date (default is today)
+// The hour and the minute are drawn within the hour and minute ranges (default is any time of day),
+// and the seconds and fractions of a second follow the precision (default is whole seconds)
+// With a precision of a minute or more, the time is a multiple of the precision from midnight
+// (e.g., 09:15 or 09:30 with a precision of 15 minutes), or the start of the ranges if none is in the ranges
+// The time is in the location of the relative date, unless WithLocation is used
+func TimeOfDay(opts ...OptionFunc) time.Time {
+	o := applyOptions(opts)
+
+	location := o.relative.Location()
+	if o.location != nil {
+		location = o.location
+	}
+	year, month, day := o.relative.In(location).Date()
+
+	// With a precision of a minute or more, draw one of the matching minutes of the day
+	if o.precision >= time.Minute {
+		var minutes []int
+		for minute := 0; minute < 24*60; minute++ {
+			if inWindow(minute/60, o.hourMin, o.hourMax) && inWindow(minute%60, o.minuteMin, o.minuteMax) &&
+				time.Duration(minute)*time.Minute%o.precision == 0 {
+				minutes = append(minutes, minute)
+			}
+		}
+		if len(minutes) == 0 {
+			return time.Date(year, month, day, o.hourMin, o.minuteMin, 0, 0, location)
+		}
+
+		minute := minutes[random.IntN(len(minutes))]
+		return time.Date(year, month, day, minute/60, minute%60, 0, 0, location)
+	}
+
+	hour := randomInWindow(o.hourMin, o.hourMax, 24)
+	minute := randomInWindow(o.minuteMin, o.minuteMax, 60)
+
+	// Draw the time within the minute in steps of the precision
+	offset := time.Duration(random.Int64N(int64(time.Minute/o.precision))) * o.precision
+
+	return time.Date(year, month, day, hour, minute, 0, int(offset), location)
+}
+
+// randomInWindow generates a random integer from min to max, both included,
+// wrapping around the given size if min is greater than max (e.g., 22 to 5 for hours)
+func randomInWindow(min, max, size int) int {
+	if min <= max {
+		return min + random.IntN(max-min+1)
+	}
+
+	return (min + random.IntN(size-min+max+1)) % size
+}
+
+// inWindow reports whether value is from min to max, both included,
+// wrapping around if min is greater than max (e.g., 22 to 5 for hours)
+func inWindow(value, min, max int) bool {
+	if min <= max {
+		return value >= min && value <= max
+	}
+	return value >= min || value <= max
+}
@@ -7,6 +7,8 @@ import (
 
 // Timezone generates a random timezone
 // The list of timezones is compliant with IANA
+// WithCountries, WithContinents, WithUTCOffsetRange and WithDST filter the timezones (see Location);
+// if no timezone matches the filters, an empty string is returned
 func Timezone(opts ...OptionFunc) string {
 	o := applyOptions(opts)
 
@@ -16,20 +18,32 @@ func Timezone(opts ...OptionFunc) string {
 		o.locale = "en"
 	}
 
+	pool := loadTimezones(o.locale)
+	if len(pool) == 0 {
+		return fallbackValues[o.locale]["timezone"]
+	}
+
+	if o.hasTimezoneFilters() {
+		pool = filterTimezones(pool, o)
+		if len(pool) == 0 {
+			return ""
+		}
+	}
+
+	return pool[random.IntN(len(pool))]
+}
+
+// loadTimezones loads the timezones of a locale, or those of the base locale if it has none
+func loadTimezones(locale string) []string {
 	// Try to load timezones from the specified locale
-	data, err := internal.LoadJsonFile("date", o.locale, "timezones.json")
+	data, err := internal.LoadJsonFile("date", locale, "timezones.json")
 	if err != nil {
 		// If not found in the specified locale, try to load from the base locale
 		data, err = internal.LoadJsonFile("date", "base", "timezones.json")
 		if err != nil {
-			return fallbackValues[o.locale]["timezone"]
+			return nil
 		}
 	}
 
-	pool := internal.GetStringSlice(data, "timezones")
-	if len(pool) == 0 {
-		return fallbackValues[o.locale]["timezone"]
-	}
-
-	return pool[random.IntN(len(pool))]
+	return internal.GetStringSlice(data, "timezones")
 }
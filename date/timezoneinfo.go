@@ -0,0 +1,126 @@
+package date
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/khchehab/muzayaf/internal"
+	"github.com/khchehab/muzayaf/random"
+)
+
+// TimezoneMetadata describes an IANA time zone
+// The abbreviations and the standard offset are those of the zone in the current rules of the tz database,
+// and abbreviations such as "+04" are used where the database has no alphabetic one
+type TimezoneMetadata struct {
+	Name                 string        // The IANA name (e.g., "Europe/Paris")
+	Country              string        // The ISO 3166-1 alpha-2 country code, empty for zones without a country (e.g., "UTC")
+	Canonical            bool          // Whether the name is a zone of its own rather than an alias (link)
+	LinkTo               string        // The canonical zone of an alias (e.g., "Europe/Berlin" for "Europe/Oslo")
+	StandardAbbreviation string        // The abbreviation of standard time (e.g., "CET")
+	DaylightAbbreviation string        // The abbreviation of daylight saving time (e.g., "CEST"), empty without DST
+	StandardOffset       time.Duration // The UTC offset of standard time
+}
+
+// TimezoneInfo generates a random time zone with its metadata
+// WithCountries, WithContinents, WithUTCOffsetRange and WithDST filter the time zones (see Location);
+// if no time zone matches the filters, the zero value is returned
+func TimezoneInfo(opts ...OptionFunc) TimezoneMetadata {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	metadata := loadTimezoneMetadata(o.locale)
+
+	var pool []TimezoneMetadata
+	for _, zone := range metadata {
+		if len(o.countries) > 0 && !containsFold(o.countries, zone.Country) {
+			continue
+		}
+		if o.hasLocationFilters() {
+			location := loadLocation(zone.Name)
+			if location == nil || !o.matchesTimezone(zone.Name, location) {
+				continue
+			}
+		}
+		pool = append(pool, zone)
+	}
+
+	if len(pool) == 0 {
+		return TimezoneMetadata{}
+	}
+
+	return pool[random.IntN(len(pool))]
+}
+
+// loadTimezoneMetadata loads the time zone metadata of a locale, or that of the base locale if it has none
+func loadTimezoneMetadata(locale string) []TimezoneMetadata {
+	// Try to load the metadata from the specified locale
+	data, err := internal.LoadJsonFile("date", locale, "timezone_info.json")
+	if err != nil {
+		// If not found in the specified locale, try to load from the base locale
+		data, err = internal.LoadJsonFile("date", "base", "timezone_info.json")
+		if err != nil {
+			return nil
+		}
+	}
+
+	var metadata []TimezoneMetadata
+	for _, entry := range internal.GetMapSlice(data, "timezones") {
+		offset, ok := parseUTCOffset(internal.GetString(entry, "standard_offset"))
+		name := internal.GetString(entry, "name")
+		if !ok || name == "" {
+			continue
+		}
+
+		link := internal.GetString(entry, "link")
+		metadata = append(metadata, TimezoneMetadata{
+			Name:                 name,
+			Country:              internal.GetString(entry, "country"),
+			Canonical:            link == "",
+			LinkTo:               link,
+			StandardAbbreviation: internal.GetString(entry, "standard_abbreviation"),
+			DaylightAbbreviation: internal.GetString(entry, "daylight_abbreviation"),
+			StandardOffset:       offset,
+		})
+	}
+
+	return metadata
+}
+
+// parseUTCOffset parses a UTC offset in the "+hh:mm" or "-hh:mm" form
+func parseUTCOffset(s string) (time.Duration, bool) {
+	if len(s) != 6 || (s[0] != '+' && s[0] != '-') || s[3] != ':' {
+		return 0, false
+	}
+
+	hours, err := strconv.Atoi(s[1:3])
+	if err != nil {
+		return 0, false
+	}
+	minutes, err := strconv.Atoi(s[4:6])
+	if err != nil || minutes >= 60 {
+		return 0, false
+	}
+
+	offset := time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute
+	if s[0] == '-' {
+		offset = -offset
+	}
+
+	return offset, true
+}
+
+// containsFold reports whether a slice contains a string, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, s) {
+			return true
+		}
+	}
+	return false
+}
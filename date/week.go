@@ -0,0 +1,80 @@
+package date
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/khchehab/muzayaf/internal"
+)
+
+// weekRules holds the week conventions of a locale
+type weekRules struct {
+	firstDay time.Weekday
+	weekend  []time.Weekday
+}
+
+// fallbackWeekRules holds the week conventions used when no locale data can be loaded
+// The week conventions of the locales live in locales/<locale>/date/week.json
+var fallbackWeekRules = weekRules{firstDay: time.Sunday, weekend: []time.Weekday{time.Saturday, time.Sunday}}
+
+// FirstDayOfWeek returns the first day of the week of the locale (default is "en")
+// (e.g., Sunday in "en", Monday in "de" and Saturday in "ar")
+func FirstDayOfWeek(opts ...OptionFunc) time.Weekday {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	return loadWeekRules(o.locale).firstDay
+}
+
+// Weekend returns the weekend days of the locale (default is "en"), from the first to the last
+// (e.g., Saturday and Sunday in "en", Friday and Saturday in "ar" and only Friday in "fa")
+func Weekend(opts ...OptionFunc) []time.Weekday {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	return slices.Clone(loadWeekRules(o.locale).weekend)
+}
+
+// loadWeekRules loads the week conventions of a locale, or those of "en" if the locale has none
+func loadWeekRules(locale string) weekRules {
+	// Try to load the week conventions from the specified locale
+	data, err := internal.LoadJsonFile("date", locale, "week.json")
+	if err != nil {
+		// If not found in the specified locale, try to load from "en"
+		data, _ = internal.LoadJsonFile("date", "en", "week.json")
+	}
+
+	return parseWeekRules(data)
+}
+
+// parseWeekRules parses the week conventions of week.json data
+// Missing or invalid entries are taken from the fallback week rules; a weekend must leave at least one working day,
+// so that WithWeekend(false) always has days to pick from
+func parseWeekRules(data map[string]any) weekRules {
+	rules := weekRules{firstDay: fallbackWeekRules.firstDay}
+	if day, exists := weekdayNames[strings.ToLower(internal.GetString(data, "first_day"))]; exists {
+		rules.firstDay = day
+	}
+
+	for _, name := range internal.GetStringSlice(data, "weekend") {
+		if day, exists := weekdayNames[strings.ToLower(name)]; exists && !slices.Contains(rules.weekend, day) {
+			rules.weekend = append(rules.weekend, day)
+		}
+	}
+	if len(rules.weekend) == 0 || len(rules.weekend) == 7 {
+		rules.weekend = fallbackWeekRules.weekend
+	}
+
+	return rules
+}
@@ -1,12 +1,17 @@
 package date
 
 import (
+	"slices"
+	"time"
+
 	"github.com/khchehab/muzayaf/internal"
 	"github.com/khchehab/muzayaf/random"
 )
 
 // Weekday returns a random weekday name
-// It can use a specific locale if specified in the options (default is "en")
+// It can use a specific locale if specified in the options (default is "en"),
+// abbreviated or narrow names with WithNameWidth (e.g., "Wed" or "W"),
+// and only the weekend days of the locale or only the other days with WithWeekend
 func Weekday(opts ...OptionFunc) string {
 	o := applyOptions(opts)
 
@@ -16,16 +21,67 @@ func Weekday(opts ...OptionFunc) string {
 		o.locale = "en"
 	}
 
+	if o.weekendFilter {
+		return WeekdayName(WeekdayValue(opts...), opts...)
+	}
+
 	// Try to load weekdays from the specified locale
 	data, err := internal.LoadJsonFile("date", o.locale, "weekdays.json")
 	if err != nil {
 		return fallbackValues[o.locale]["weekday"]
 	}
 
-	pool := internal.GetStringSlice(data, "weekdays")
+	pool := internal.GetStringSlice(data, nameKey("weekdays", o.nameWidth))
 	if len(pool) == 0 {
 		return fallbackValues[o.locale]["weekday"]
 	}
 
 	return pool[random.IntN(len(pool))]
 }
+
+// WeekdayValue returns a random weekday as a time.Weekday
+// With WithWeekend it is one of the weekend days of the locale (default is "en"), or one of the other days;
+// a weekend always has at least one day and leaves at least one other day (see Weekend)
+func WeekdayValue(opts ...OptionFunc) time.Weekday {
+	o := applyOptions(opts)
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	days := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	if o.weekendFilter {
+		weekend := loadWeekRules(o.locale).weekend
+		days = slices.DeleteFunc(days, func(day time.Weekday) bool {
+			return slices.Contains(weekend, day) != o.weekend
+		})
+	}
+
+	return days[random.IntN(len(days))]
+}
+
+// WeekdayName returns the name of a weekday in the locale (default is "en") and the width (default is NameWide)
+// of the options, or its English name if the locale has no names in that width
+// Weekdays out of range return an empty string
+func WeekdayName(day time.Weekday, opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	if day < time.Sunday || day > time.Saturday {
+		return ""
+	}
+
+	// Validate locale
+	if _, exists := fallbackValues[o.locale]; !exists {
+		// If locale doesn't exist in fallbackValues, use "en" as fallback
+		o.locale = "en"
+	}
+
+	// The weekday names start on Monday
+	if names := loadNames(o.locale, "weekdays.json", "weekdays", o.nameWidth, 7); names != nil {
+		return names[(day+6)%7]
+	}
+
+	return day.String()
+}
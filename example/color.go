@@ -1,8 +1,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/khchehab/muzayaf/color"
+	"image"
 )
 
 func mainColor() {
@@ -26,9 +28,128 @@ func mainColor() {
 	fmt.Printf("Another random RGBA color: %s\n", color.RGBA())
 	fmt.Printf("Random RGB color (full alpha): %s\n", color.RGB())
 
+	// Constrained color examples
+	fmt.Println("\nConstrained Color Examples:")
+	fmt.Printf("Random red-ish color: %s\n", color.HSL(color.WithHueRange(330, 30)))
+	fmt.Printf("Random pastel color: %s\n", color.RGB(color.WithPreset(color.PresetPastel)))
+	fmt.Printf("Random vivid color: %s\n", color.HSL(color.WithPreset(color.PresetVivid)))
+	fmt.Printf("Random dark CMYK color: %s\n", color.CMYK(color.WithPreset(color.PresetDark)))
+	fmt.Printf("Random translucent light color: %s\n", color.RGBA(color.WithPreset(color.PresetLight), color.WithAlphaRange(0.3, 0.6)))
+	fmt.Printf("Random gray: %s\n", color.RGB(color.WithGrayscale(true), color.WithLightnessRange(30, 70)))
+
+	// Palette examples
+	fmt.Println("\nPalette Examples:")
+	fmt.Printf("Random triadic palette: %v\n", color.Palette(3, color.SchemeTriadic))
+	fmt.Printf("Random pastel analogous palette: %v\n", color.Palette(5, color.SchemeAnalogous, color.WithPreset(color.PresetPastel)))
+	steelBlue := color.HSLAColor{Hue: 210, Saturation: 50, Lightness: 40, Alpha: 1.0}
+	fmt.Printf("Complementary palette of %s: %v\n", steelBlue, color.Palette(2, color.SchemeComplementary, color.WithPaletteBase(steelBlue)))
+	fmt.Printf("Distinct palette for 8 chart series: %v\n", color.Palette(8, color.SchemeDistinct))
+
+	// Contrast examples
+	fmt.Println("\nContrast Examples:")
+	foreground, background := color.ContrastPair(color.ContrastAA)
+	fmt.Printf("AA text pair: %s on %s (ratio %.2f)\n", foreground, background, foreground.ContrastRatio(background))
+	foreground, background = color.ContrastPair(color.ContrastAAA, color.WithPreset(color.PresetDark))
+	fmt.Printf("AAA text pair on a dark color: %s on %s (ratio %.2f)\n", foreground, background, foreground.ContrastRatio(background))
+	foreground, background = color.LowContrastPair(color.ContrastAA)
+	fmt.Printf("Pair just failing AA: %s on %s (ratio %.2f)\n", foreground, background, foreground.ContrastRatio(background))
+	fmt.Printf("Luminance of %s: %.4f\n", background, background.Luminance())
+
+	// Conversion examples
+	fmt.Println("\nConversion Examples:")
+	converted := color.RGB()
+	fmt.Printf("Random color as RGB: %s\n", converted)
+	fmt.Printf("Same color as HSL: %s\n", converted.ToHSLA())
+	fmt.Printf("Same color as CMYK: %s\n", converted.ToCMYK())
+
+	// Color space examples
+	fmt.Println("\nColor Space Examples:")
+	fmt.Printf("Random HSV color: %s\n", color.HSV())
+	fmt.Printf("Random HWB color: %s\n", color.HWB())
+	fmt.Printf("Random Lab color: %s\n", color.Lab())
+	fmt.Printf("Random OKLCH color: %s\n", color.OKLCH())
+	fmt.Printf("Random color as OKLCH: %s\n", converted.ToOKLCH())
+	wide := color.OKLCHColor{L: 0.7, C: 0.4, H: 150, Alpha: 1.0}
+	fmt.Printf("Out of gamut %s mapped to sRGB: %s\n", wide, wide.ToRGBA())
+	fmt.Printf("Difference between %s and %s: ΔE2000 = %.2f\n", converted, wide, color.DeltaE2000(converted, wide))
+
+	// Deterministic color examples
+	fmt.Println("\nDeterministic Color Examples:")
+	for _, user := range []string{"alice", "bob", "alice"} {
+		fmt.Printf("Avatar color of %s: %s\n", user, color.FromString(user, color.WithPreset(color.PresetVivid)).Hex())
+	}
+	fmt.Printf("Tag color of %q: %s\n", "bug", color.FromBytes([]byte("bug"), color.WithLightnessRange(20, 40)))
+
+	// Gradient and theme examples
+	fmt.Println("\nGradient and Theme Examples:")
+	fmt.Printf("Random linear gradient: %s\n", color.LinearGradient(3))
+	fmt.Printf("Random pastel radial gradient: %s\n", color.RadialGradient(4, color.WithPreset(color.PresetPastel)))
+	gradientTokens, _ := color.LinearGradient(2, color.WithGradientAngle(90)).DesignTokens()
+	fmt.Printf("Gradient as design tokens:\n%s\n", gradientTokens)
+	theme := color.Theme()
+	fmt.Printf("Random theme as CSS custom properties:\n%s", theme.CSS())
+	themeTokens, _ := theme.DesignTokens()
+	fmt.Printf("Same theme as design tokens:\n%s\n", themeTokens)
+
+	// Color vision deficiency examples
+	fmt.Println("\nColor Vision Deficiency Examples:")
+	for _, deficiency := range []string{color.DeficiencyProtanopia, color.DeficiencyDeuteranopia, color.DeficiencyTritanopia, color.DeficiencyAchromatopsia} {
+		fmt.Printf("%s with %s: %s\n", converted, deficiency, converted.Simulate(deficiency))
+	}
+	safe := color.SafePalette(6)
+	fmt.Printf("Color-blind safe palette: %v (distinguishable: %t)\n", safe, color.Distinguishable(safe))
+	chart := color.Palette(6, color.SchemeDistinct)
+	fmt.Printf("Distinct palette: %v (distinguishable: %t)\n", chart, color.Distinguishable(chart))
+
+	// Hex examples
+	fmt.Println("\nHex Examples:")
+	fmt.Printf("Random hex color: %s\n", color.Hex())
+	fmt.Printf("Random shorthand hex color: %s\n", color.Hex(color.WithHexDigits(3)))
+	fmt.Printf("Random uppercase hex color with alpha: %s\n", color.Hex(color.WithHexDigits(8), color.WithHexUppercase(true)))
+	fmt.Printf("Random color as hex: %s\n", converted.Hex())
+
+	// ANSI examples
+	fmt.Println("\nANSI Examples:")
+	fmt.Printf("Random truecolor text: %sexample%s\n", color.ANSI(), color.ANSIReset)
+	fmt.Printf("Random 256-color background: %sexample%s\n", color.ANSI(color.WithANSIMode(color.ANSIMode256), color.WithANSIBackground(true)), color.ANSIReset)
+	fmt.Printf("Random 16-color text: %sexample%s\n", color.ANSI(color.WithANSIMode(color.ANSIMode16)), color.ANSIReset)
+	fmt.Printf("Random color %s as 256-color index %d and 16-color index %d\n", converted, converted.ANSI256(), converted.ANSI16())
+	fmt.Printf("Random styled text: %s\n", color.Styled("example"))
+
+	// Parsing examples
+	fmt.Println("\nParsing Examples:")
+	for _, str := range []string{"#ff8800", "rgba(157, 191, 251, 0.87)", "hsl(270°, 24%, 74%)", "cmyk(75%, 24%, 74%, 87%)", "hwb(207 27% 29%)", "oklch(70% 0.15 150 / 50%)", "rebeccapurple"} {
+		parsed, err := color.Parse(str)
+		if err != nil {
+			fmt.Printf("Could not parse %q: %v\n", str, err)
+			continue
+		}
+		fmt.Printf("Parsed %q as RGBA: %s\n", str, parsed.ToRGBA())
+	}
+
+	// Encoding examples
+	fmt.Println("\nEncoding Examples:")
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, converted.ToOKLCH())
+	fmt.Printf("Pixel drawn with %s: %s\n", converted.ToOKLCH(), color.FromColor(img.At(0, 0)))
+	data, _ := json.Marshal(struct {
+		Primary color.RGBAColor  `json:"primary"`
+		Accent  color.OKLCHColor `json:"accent"`
+	}{converted, color.OKLCH()})
+	fmt.Printf("Colors as JSON: %s\n", data)
+	var scanned color.HSLAColor
+	if err := scanned.Scan("#336699"); err == nil {
+		fmt.Printf("Scanned #336699 as HSLA: %s\n", scanned)
+	}
+
 	// Color name examples
 	fmt.Println("\nColor Name Examples:")
 	fmt.Printf("Random color name: %s\n", color.ColorName())
 	fmt.Printf("Random color name with locale: %s\n", color.ColorName(color.WithLocale("en")))
 	fmt.Printf("Another random color name: %s\n", color.ColorName())
+	fmt.Printf("Random German color name: %s\n", color.ColorName(color.WithLocale("de")))
+	name, value := color.Named()
+	fmt.Printf("Random named color: %s is %s (%s)\n", name, value, value.Hex())
+	generated := color.RGB()
+	fmt.Printf("Nearest name of %s: %s\n", generated, color.NearestName(generated))
 }
@@ -41,6 +41,47 @@ func mainDate() {
 	pastDateRelative := date.Past(date.WithRelative(relativeDate))
 	fmt.Printf("Random Past Date (relative to 2000-01-01): %s\n\n", pastDateRelative.Format("2006-01-02"))
 
+	// Generate a random date with sub-second precision
+	preciseDate := date.Between(from, to, date.WithPrecision(time.Millisecond))
+	fmt.Printf("Random Date With Millisecond Precision: %s\n", preciseDate.Format(time.RFC3339Nano))
+
+	// Generate a random time of day
+	timeOfDay := date.TimeOfDay(date.WithHourRange(9, 17))
+	fmt.Printf("Random Time Of Day Between 09:00 and 17:59: %s\n", timeOfDay.Format("15:04:05"))
+
+	// Generate a random night time in quarter hours
+	nightTime := date.TimeOfDay(date.WithHourRange(22, 5), date.WithPrecision(15*time.Minute))
+	fmt.Printf("Random Night Time: %s\n", nightTime.Format("15:04"))
+
+	// Generate a random duration
+	duration := date.Duration(10*time.Minute, 2*time.Hour, date.WithPrecision(time.Minute))
+	fmt.Printf("Random Duration In Whole Minutes: %s\n", duration)
+
+	// Generate a random time within business hours
+	businessTime := date.BusinessHours(from, to)
+	fmt.Printf("Random Business Time: %s\n", businessTime.Format("Monday 2006-01-02 15:04:05"))
+
+	// Generate a random time within night shifts in a time zone
+	if tokyo, err := time.LoadLocation("Asia/Tokyo"); err == nil {
+		shiftTime := date.BusinessHours(from, to, date.WithLocation(tokyo), date.WithWorkingHours(22*time.Hour, 6*time.Hour))
+		fmt.Printf("Random Night Shift Time In Tokyo: %s\n\n", shiftTime.Format("Monday 2006-01-02 15:04:05 MST"))
+	}
+
+	// List the public holidays of a country
+	for _, holiday := range date.Holidays("US", 2024) {
+		fmt.Printf("US Holiday: %s on %s (observed %s)\n", holiday.Name, holiday.Date.Format("Mon 2006-01-02"), holiday.Observed.Format("Mon 2006-01-02"))
+	}
+
+	// Generate a random public holiday
+	holiday := date.Holiday("DE", 2024)
+	fmt.Printf("Random German Holiday: %s (%s) on %s\n", holiday.LocalName, holiday.Name, holiday.Date.Format("2006-01-02"))
+
+	// Generate random dates on business days or on public holidays
+	businessDay := date.Between(from, to, date.WithBusinessDaysOnly("GB"))
+	fmt.Printf("Random UK Business Day: %s\n", businessDay.Format("Monday 2006-01-02"))
+	holidayDate := date.Past(date.WithYears(5), date.WithHolidaysOnly("FR"))
+	fmt.Printf("Random French Holiday In The Past 5 Years: %s\n\n", holidayDate.Format("Monday 2006-01-02"))
+
 	// Generate a random month
 	month := date.Month()
 	fmt.Printf("Random Month: %s\n", month)
@@ -51,7 +92,43 @@ func mainDate() {
 
 	// Generate a random timezone
 	timezone := date.Timezone()
-	fmt.Printf("Random Timezone: %s\n\n", timezone)
+	fmt.Printf("Random Timezone: %s\n", timezone)
+
+	// Generate a random timezone in Asia without DST
+	asianTimezone := date.Timezone(date.WithContinents("Asia"), date.WithDST(false))
+	fmt.Printf("Random Asian Timezone Without DST: %s\n", asianTimezone)
+
+	// Generate a random timezone with its metadata
+	info := date.TimezoneInfo(date.WithCountries("CA", "MX"))
+	fmt.Printf("Random Timezone In Canada Or Mexico: %s (%s, standard %s UTC%+g", info.Name, info.Country, info.StandardAbbreviation, info.StandardOffset.Hours())
+	if info.DaylightAbbreviation != "" {
+		fmt.Printf(", daylight %s", info.DaylightAbbreviation)
+	}
+	if !info.Canonical {
+		fmt.Printf(", alias of %s", info.LinkTo)
+	}
+	fmt.Println(")")
+
+	// Generate random dates in a random or a given time zone
+	zonedDate := date.Zoned(date.WithYears(1), date.WithUTCOffsetRange(time.Hour, 3*time.Hour))
+	fmt.Printf("Random Date In A UTC+1 To UTC+3 Time Zone: %s\n", zonedDate.Format(time.RFC1123Z))
+	tokyo, _ := time.LoadLocation("Asia/Tokyo")
+	tokyoDate := date.ZonedBetween(from, to, date.WithLocation(tokyo))
+	fmt.Printf("Random Date In Tokyo: %s\n", tokyoDate.Format(time.RFC1123Z))
+
+	// Generate DST edge cases
+	gap := date.DSTEdge(date.WithDSTEdgeKind(date.DSTNonexistent), date.WithYears(5))
+	fmt.Printf("Nonexistent Time: %s in %s (read as %s or %s)\n", gap.Local, gap.Location, gap.Before.Format("15:04:05 MST"), gap.After.Format("15:04:05 MST"))
+	overlap := date.DSTEdge(date.WithDSTEdgeKind(date.DSTAmbiguous), date.WithYears(5))
+	fmt.Printf("Ambiguous Time: %s in %s (either %s or %s)\n\n", overlap.Local, overlap.Location, overlap.Before.Format("15:04:05 MST"), overlap.After.Format("15:04:05 MST"))
+
+	// Generate random formatted dates
+	fmt.Printf("Random Formatted Date: %s\n", date.Formatted())
+	fmt.Printf("Random Unix Time: %s\n", date.Formatted(date.WithLayouts(date.LayoutUnix, date.LayoutUnixMilli)))
+	for _, locale := range []string{"en", "ar", "de", "fa", "hi"} {
+		fmt.Printf("Random Long Date (%s locale): %s\n", locale, date.Formatted(date.WithLayouts(date.LayoutLong), date.WithLocale(locale)))
+	}
+	fmt.Println()
 
 	// Generate a random weekday
 	weekday := date.Weekday()
@@ -60,4 +137,30 @@ func mainDate() {
 	// Generate a random weekday with a specific locale
 	weekdayWithLocale := date.Weekday(date.WithLocale("en"))
 	fmt.Printf("Random Weekday (en locale): %s\n", weekdayWithLocale)
+
+	// Generate typed months and weekdays with abbreviated and narrow names
+	monthValue := date.MonthValue()
+	fmt.Printf("Random Month Value: %d (%s, %s)\n", monthValue, date.MonthName(monthValue, date.WithNameWidth(date.NameAbbreviated)), date.MonthName(monthValue, date.WithNameWidth(date.NameNarrow)))
+	weekendDay := date.WeekdayValue(date.WithWeekend(true), date.WithLocale("ar"))
+	fmt.Printf("Random Weekend Day (ar locale): %s (%s)\n", weekendDay, date.WeekdayName(weekendDay, date.WithLocale("ar")))
+
+	// Look up the week rules of locales
+	for _, locale := range []string{"en", "de", "ar", "fa", "hi"} {
+		fmt.Printf("Week (%s locale): starts on %s, weekend %v\n", locale, date.FirstDayOfWeek(date.WithLocale(locale)), date.Weekend(date.WithLocale(locale)))
+	}
+
+	// Generate dates in the Hijri, Persian and Hebrew calendars with localized month names
+	hijriDate := date.Hijri()
+	fmt.Printf("Random Hijri Date: %s (%s, %s)\n", hijriDate, hijriDate.MonthName(), hijriDate.MonthName(date.WithLocale("ar")))
+	persianDate := date.Persian()
+	fmt.Printf("Random Persian Date: %s (%s, %s)\n", persianDate, persianDate.MonthName(), persianDate.MonthName(date.WithLocale("fa")))
+	hebrewDate := date.Hebrew()
+	fmt.Printf("Random Hebrew Date: %s (%s)\n", hebrewDate, hebrewDate.MonthName())
+	ramadan := date.CalendarBetween(
+		date.CalendarDate{Calendar: date.CalendarHijri, Year: 1446, Month: 9, Day: 1},
+		date.CalendarDate{Calendar: date.CalendarHijri, Year: 1446, Month: 9, Day: 29},
+	)
+	if gregorian, err := ramadan.Time(time.UTC); err == nil {
+		fmt.Printf("Random Day of Ramadan 1446: %s (%s)\n", ramadan, gregorian.Format("2006-01-02"))
+	}
 }
@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"github.com/khchehab/muzayaf/number"
+	"time"
 )
 
 func mainNumber() {
@@ -14,6 +15,10 @@ func mainNumber() {
 	fmt.Printf("Random integer: %d\n", number.Int())
 	fmt.Printf("Random integer (10-20): %d\n", number.Int(number.WithIntMin(10), number.WithIntMax(20)))
 	fmt.Printf("Random integer (multiple of 5): %d\n", number.Int(number.WithIntMin(10), number.WithIntMax(50), number.WithIntMultiple(5)))
+	fmt.Printf("Random integer (1-10, excluding 5): %d\n", number.Int(number.WithIntMin(1), number.WithIntMax(10), number.WithIntExclude(5)))
+	fmt.Printf("Random integer with edge cases (50%%): %d\n", number.Int(number.WithEdgeCaseProbability(0.5)))
+	fmt.Printf("Random prime integer (1-1000): %d\n", number.Int(number.WithIntMin(1), number.WithIntMax(1000), number.WithIntPrime()))
+	fmt.Printf("Random distinct integers (1-100): %v\n", number.Ints(5, number.WithIntMin(1), number.WithIntMax(100)))
 
 	// Float examples
 	fmt.Println("\nFloat Examples:")
@@ -21,6 +26,9 @@ func mainNumber() {
 	fmt.Printf("Random float (1.5-3.5): %f\n", number.Float(number.WithFloatMin(1.5), number.WithFloatMax(3.5)))
 	fmt.Printf("Random float (3 decimal places): %f\n", number.Float(number.WithFloatFractionDigits(3)))
 
+	fmt.Printf("Random float with edge cases (50%%): %v\n", number.Float(number.WithEdgeCaseProbability(0.5)))
+	fmt.Printf("Random float with edge cases and NaN or ±Inf (50%%): %v\n", number.Float(number.WithEdgeCaseProbability(0.5), number.WithEdgeCaseNonFinite(true)))
+
 	// Binary examples
 	fmt.Println("\nBinary Examples:")
 	fmt.Printf("Random binary: %s\n", number.Binary())
@@ -44,4 +52,37 @@ func mainNumber() {
 	fmt.Printf("Random roman numeral: %s\n", number.Roman())
 	fmt.Printf("Random roman numeral (1-10): %s\n", number.Roman(number.WithRomanMin(1), number.WithRomanMax(10)))
 	fmt.Printf("Random roman numeral (50-100): %s\n", number.Roman(number.WithRomanMin(50), number.WithRomanMax(100)))
+	fmt.Printf("Random lowercase roman numeral: %s\n", number.Roman(number.WithRomanLowercase(true)))
+	fmt.Printf("Random unicode roman numeral: %s\n", number.Roman(number.WithRomanUnicode(true)))
+	fmt.Printf("Random clock face roman numeral (1-12): %s\n", number.Roman(number.WithRomanMax(12), number.WithRomanNotation(number.RomanNotationAdditive)))
+	fmt.Printf("Random vinculum roman numeral: %s\n", number.Roman(number.WithRomanMax(3999999), number.WithRomanNotation(number.RomanNotationVinculum)))
+	if value, err := number.ParseRoman("MCMXCIV"); err == nil {
+		fmt.Printf("Parsed roman numeral MCMXCIV: %d\n", value)
+	}
+
+	// Formatted number examples
+	fmt.Println("\nFormatted Number Examples:")
+	fmt.Printf("Random formatted number: %s\n", number.Formatted())
+	fmt.Printf("Random formatted number (de): %s\n", number.Formatted(number.WithLocale("de")))
+	fmt.Printf("Random formatted number (ar): %s\n", number.Formatted(number.WithLocale("ar")))
+	fmt.Printf("Random formatted number (hi, lakh/crore grouping): %s\n", number.Formatted(number.WithLocale("hi"), number.WithFormattedMax(1000000000)))
+	fmt.Printf("Random formatted percent: %s\n", number.Formatted(number.WithFormattedMax(1), number.WithFormattedStyle(number.FormatStylePercent)))
+	fmt.Printf("Random formatted scientific: %s\n", number.Formatted(number.WithFormattedStyle(number.FormatStyleScientific)))
+
+	// Sequence examples
+	fmt.Println("\nSequence Examples:")
+	sequence := number.NewSequence(number.WithSequenceStart(1000), number.WithSequenceStep(10))
+	fmt.Printf("Auto-increment sequence: %v\n", sequence.Take(5))
+	fmt.Printf("Random sorted integers (1-100): %v\n", number.SortedInts(5, number.WithIntMin(1), number.WithIntMax(100)))
+	fmt.Printf("Snowflake ID: %d\n", number.NewSnowflake(number.WithSnowflakeWorker(1)).Next())
+	fmt.Printf("Snowflake IDs generated over the past week: %v\n", number.SnowflakeIDs(3, time.Now().AddDate(0, 0, -7), time.Now()))
+
+	// Vector and matrix examples
+	fmt.Println("\nVector and Matrix Examples:")
+	fmt.Printf("Random vector: %v\n", number.Vector(3))
+	fmt.Printf("Random Gaussian vector: %v\n", number.GaussianVector(3, number.WithVectorMean(10), number.WithVectorStdDev(2)))
+	fmt.Printf("Random unit vector (fake embedding): %v\n", number.UnitVector(4))
+	fmt.Printf("Random symmetric matrix: %v\n", number.SymmetricMatrix(2))
+	fmt.Printf("Random positive-definite matrix: %v\n", number.PositiveDefiniteMatrix(2))
+	fmt.Printf("Random budget split: %v\n", number.Proportions(4, number.WithProportionsTotal(1000), number.WithProportionsFractionDigits(2)))
 }
@@ -0,0 +1,28 @@
+package internal
+
+// NumberingSystemDigits maps each numbering system to its digits from zero to nine
+// The keys are the Unicode CLDR numbering system identifiers
+var NumberingSystemDigits = map[string][10]rune{
+	"latn":    {'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'},
+	"arab":    {'٠', '١', '٢', '٣', '٤', '٥', '٦', '٧', '٨', '٩'},
+	"arabext": {'۰', '۱', '۲', '۳', '۴', '۵', '۶', '۷', '۸', '۹'},
+	"deva":    {'०', '१', '२', '३', '४', '५', '६', '७', '८', '९'},
+}
+
+// LocalizeDigits replaces the ASCII digits of a string with the digits of a numbering system
+// Unknown numbering systems leave the string unchanged
+func LocalizeDigits(s, system string) string {
+	digits, exists := NumberingSystemDigits[system]
+	if !exists {
+		return s
+	}
+
+	result := []rune(s)
+	for i, r := range result {
+		if r >= '0' && r <= '9' {
+			result[i] = digits[r-'0']
+		}
+	}
+
+	return string(result)
+}
@@ -29,6 +29,11 @@ func LoadJsonFile(packageName, locale, fileName string) (map[string]any, error)
 	}
 	jsonCacheSync.RUnlock()
 
+	// A locale is a directory of the locale tree, never a path leaving it
+	if locale == "" || locale != filepath.Base(locale) || locale == ".." {
+		return nil, fmt.Errorf("invalid locale %q", locale)
+	}
+
 	filePath := filepath.Join("..", "locales", locale, packageName, fileName)
 
 	data, err := os.ReadFile(filePath)
@@ -72,3 +77,79 @@ func GetStringSlice(data map[string]any, key string) []string {
 
 	return slice
 }
+
+// GetString extracts a string from a map by key
+// Returns an empty string if the key doesn't exist or the value is not a string
+func GetString(data map[string]any, key string) string {
+	if data == nil {
+		return ""
+	}
+
+	value, ok := data[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// GetInt extracts an integer from a map by key
+// Returns zero if the key doesn't exist or the value is not a number
+func GetInt(data map[string]any, key string) int {
+	if data == nil {
+		return 0
+	}
+
+	value, ok := data[key].(float64)
+	if !ok {
+		return 0
+	}
+
+	return int(value)
+}
+
+// GetStringMap extracts a map of strings from a map by key
+// Returns an empty map if the key doesn't exist or the value is not an object
+// Entries whose value is not a string are skipped
+func GetStringMap(data map[string]any, key string) map[string]string {
+	if data == nil {
+		return map[string]string{}
+	}
+
+	value, ok := data[key].(map[string]any)
+	if !ok {
+		return map[string]string{}
+	}
+
+	result := make(map[string]string, len(value))
+	for k, v := range value {
+		if str, ok := v.(string); ok {
+			result[k] = str
+		}
+	}
+
+	return result
+}
+
+// GetMapSlice extracts a slice of objects from a map by key
+// Returns an empty slice if the key doesn't exist or the value is not a slice
+// Elements that are not objects are skipped
+func GetMapSlice(data map[string]any, key string) []map[string]any {
+	if data == nil {
+		return []map[string]any{}
+	}
+
+	anySlice, ok := data[key].([]any)
+	if !ok {
+		return []map[string]any{}
+	}
+
+	slice := make([]map[string]any, 0, len(anySlice))
+	for _, v := range anySlice {
+		if m, ok := v.(map[string]any); ok {
+			slice = append(slice, m)
+		}
+	}
+
+	return slice
+}
@@ -17,9 +17,11 @@ func Binary(opts ...OptionFunc) string {
 		o.binaryMin, o.binaryMax = o.binaryMax, o.binaryMin
 	}
 
-	// Generate a random number in the range
+	// Generate a random number in the range, or a boundary value if edge cases are enabled
 	var value int
-	if o.binaryMin == o.binaryMax {
+	if edgeValue, ok := edgeCaseInt(o, o.binaryMin, o.binaryMax, nil); ok {
+		value = edgeValue
+	} else if o.binaryMin == o.binaryMax {
 		value = o.binaryMin
 	} else {
 		value = o.binaryMin + random.IntN(o.binaryMax-o.binaryMin+1)
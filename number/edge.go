@@ -0,0 +1,94 @@
+package number
+
+import (
+	"github.com/khchehab/muzayaf/random"
+	"math"
+	"slices"
+)
+
+// largestSubnormalFloat is the largest subnormal float64, just below math.SmallestNormalFloat64
+var largestSubnormalFloat = math.Float64frombits(0x000fffffffffffff)
+
+// edgeCaseInt returns a boundary value for an integer generator with the configured edge case probability
+// Candidates are 0, ±1, math.MaxInt, math.MinInt and the values at and next to min and max,
+// restricted to [min, max] and to the values accepted by accept (if not nil)
+// The second return value is false if no edge case was picked
+func edgeCaseInt(o Option, min, max int, accept func(int) bool) (int, bool) {
+	if o.edgeCaseProbability <= 0 || random.Float64() >= o.edgeCaseProbability {
+		return 0, false
+	}
+
+	candidates := []int{0, -1, 1, math.MaxInt, math.MinInt, min, max}
+	if min < math.MaxInt {
+		candidates = append(candidates, min+1)
+	}
+	if max > math.MinInt {
+		candidates = append(candidates, max-1)
+	}
+
+	pool := make([]int, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate < min || candidate > max || (accept != nil && !accept(candidate)) {
+			continue
+		}
+		if !slices.Contains(pool, candidate) {
+			pool = append(pool, candidate)
+		}
+	}
+
+	if len(pool) == 0 {
+		return 0, false
+	}
+
+	return pool[random.IntN(len(pool))], true
+}
+
+// edgeCaseFloat returns a special value for a float generator with the configured edge case probability
+// Candidates are ±Inf, 0, -0.0, ±1, ±math.MaxFloat64, the smallest and largest subnormals and the values
+// at and next to min and max, restricted to [min, max], and NaN if the range is unbounded on both sides;
+// NaN and ±Inf are always included with WithEdgeCaseNonFinite
+// The second return value is false if no edge case was picked
+func edgeCaseFloat(o Option, min, max float64) (float64, bool) {
+	if o.edgeCaseProbability <= 0 || random.Float64() >= o.edgeCaseProbability {
+		return 0, false
+	}
+
+	var pool []float64
+	if o.edgeCaseNonFinite || (math.IsInf(min, -1) && math.IsInf(max, 1)) {
+		pool = append(pool, math.NaN())
+	}
+	if o.edgeCaseNonFinite {
+		pool = append(pool, math.Inf(1), math.Inf(-1))
+	}
+
+	candidates := []float64{
+		math.Inf(1), math.Inf(-1),
+		0, math.Copysign(0, -1), 1, -1,
+		math.MaxFloat64, -math.MaxFloat64,
+		math.SmallestNonzeroFloat64, -math.SmallestNonzeroFloat64,
+		largestSubnormalFloat, -largestSubnormalFloat,
+		min, max, math.Nextafter(min, math.Inf(1)), math.Nextafter(max, math.Inf(-1)),
+	}
+
+	for _, candidate := range candidates {
+		if candidate < min || candidate > max {
+			continue
+		}
+		if !containsFloatBits(pool, candidate) {
+			pool = append(pool, candidate)
+		}
+	}
+
+	return pool[random.IntN(len(pool))], true
+}
+
+// containsFloatBits reports whether the slice contains a float with the same bits as value,
+// so that 0 and -0.0 are treated as different values
+func containsFloatBits(slice []float64, value float64) bool {
+	for _, v := range slice {
+		if math.Float64bits(v) == math.Float64bits(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -14,6 +14,11 @@ func Float(opts ...OptionFunc) float64 {
 		o.floatMin, o.floatMax = o.floatMax, o.floatMin
 	}
 
+	// Pick a special value if edge cases are enabled, without rounding it
+	if value, ok := edgeCaseFloat(o, o.floatMin, o.floatMax); ok {
+		return value
+	}
+
 	// If min equals max, return min
 	if o.floatMin == o.floatMax {
 		return o.floatMin
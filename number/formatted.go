@@ -0,0 +1,215 @@
+package number
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/khchehab/muzayaf/internal"
+	"github.com/khchehab/muzayaf/random"
+)
+
+// numberFormat holds the locale-specific symbols and rules used to format numbers
+type numberFormat struct {
+	decimal           string
+	group             string
+	primaryGrouping   int
+	secondaryGrouping int
+	minusSign         string
+	percentPrefix     string
+	percentSuffix     string
+	exponential       string
+	numberingSystem   string
+}
+
+// Formatted generates a random number formatted as a string according to the conventions of a locale
+// It supports grouping separators, decimal marks, Indian lakh/crore grouping, percent and scientific notation,
+// and native digit systems such as Arabic-Indic, Persian and Devanagari
+func Formatted(opts ...OptionFunc) string {
+	o := applyOptions(opts)
+
+	// Validate options
+	if o.formattedMin > o.formattedMax {
+		o.formattedMin, o.formattedMax = o.formattedMax, o.formattedMin
+	}
+
+	// Generate a random float in the range [min, max)
+	value := o.formattedMin
+	if o.formattedMin != o.formattedMax {
+		value += random.Float64() * (o.formattedMax - o.formattedMin)
+	}
+
+	return formatNumber(value, loadNumberFormat(o.locale), o)
+}
+
+// loadNumberFormat loads the number format of a locale, or that of "en" if the locale has none
+// Missing or invalid entries are taken from the fallback values
+func loadNumberFormat(locale string) numberFormat {
+	format := fallbackValues["en"]
+
+	// Try to load the format from the specified locale
+	data, err := internal.LoadJsonFile("number", locale, "format.json")
+	if err != nil {
+		// If not found in the specified locale, try to load from "en"
+		data, err = internal.LoadJsonFile("number", "en", "format.json")
+		if err != nil {
+			return format
+		}
+	}
+
+	if decimal := internal.GetString(data, "decimal"); decimal != "" {
+		format.decimal = decimal
+	}
+	if group := internal.GetString(data, "group"); group != "" {
+		format.group = group
+	}
+	if primary := internal.GetInt(data, "primary_grouping"); primary > 0 {
+		format.primaryGrouping = primary
+	}
+	if secondary := internal.GetInt(data, "secondary_grouping"); secondary > 0 {
+		format.secondaryGrouping = secondary
+	}
+	if minusSign := internal.GetString(data, "minus_sign"); minusSign != "" {
+		format.minusSign = minusSign
+	}
+	if _, ok := data["percent_prefix"]; ok {
+		format.percentPrefix = internal.GetString(data, "percent_prefix")
+	}
+	if _, ok := data["percent_suffix"]; ok {
+		format.percentSuffix = internal.GetString(data, "percent_suffix")
+	}
+	if exponential := internal.GetString(data, "exponential"); exponential != "" {
+		format.exponential = exponential
+	}
+	if system := internal.GetString(data, "numbering_system"); system != "" {
+		if _, exists := numberingSystemDigits[system]; exists {
+			format.numberingSystem = system
+		}
+	}
+
+	return format
+}
+
+// formatNumber formats a value using the given number format and formatting options
+func formatNumber(value float64, format numberFormat, o Option) string {
+	if o.formattedStyle == FormatStylePercent {
+		value *= 100
+	}
+
+	negative := value < 0
+	value = math.Abs(value)
+
+	var result string
+	if o.formattedStyle == FormatStyleScientific {
+		result = formatScientific(value, o)
+	} else {
+		result = formatDecimal(value, format, o)
+	}
+
+	// A value that rounds to zero is never shown as negative
+	if negative && strings.Trim(result, "0.E+-") != "" {
+		result = "-" + result
+	}
+
+	// Convert the ASCII digits to the digits of the numbering system
+	system := format.numberingSystem
+	if o.formattedNumberingSystem != "" {
+		system = o.formattedNumberingSystem
+	}
+	result = localizeDigits(result, format, system)
+
+	if o.formattedStyle == FormatStylePercent {
+		return format.percentPrefix + result + format.percentSuffix
+	}
+
+	return result
+}
+
+// formatDecimal formats a non-negative value with grouped integer digits and ASCII placeholders
+// The placeholders are replaced with the locale symbols by localizeDigits
+func formatDecimal(value float64, format numberFormat, o Option) string {
+	str := strconv.FormatFloat(value, 'f', o.formattedFractionDigits, 64)
+
+	integerPart, fractionPart, hasFraction := strings.Cut(str, ".")
+	if o.formattedGrouping {
+		integerPart = groupDigits(integerPart, format.primaryGrouping, format.secondaryGrouping)
+	}
+
+	if hasFraction {
+		return integerPart + "." + fractionPart
+	}
+
+	return integerPart
+}
+
+// formatScientific formats a non-negative value in scientific notation with ASCII placeholders
+// The placeholders are replaced with the locale symbols by localizeDigits
+func formatScientific(value float64, o Option) string {
+	str := strconv.FormatFloat(value, 'e', o.formattedFractionDigits, 64)
+
+	mantissa, exponentStr, _ := strings.Cut(str, "e")
+	exponent, _ := strconv.Atoi(exponentStr)
+
+	if exponent < 0 {
+		return mantissa + "E-" + strconv.Itoa(-exponent)
+	}
+
+	return mantissa + "E" + strconv.Itoa(exponent)
+}
+
+// groupDigits inserts ',' placeholders into a string of digits
+// The primary group size applies to the rightmost group and the secondary size to all others,
+// so a primary size of 3 and a secondary size of 2 produces Indian grouping (e.g., 12,34,567)
+func groupDigits(digits string, primary, secondary int) string {
+	if primary <= 0 || len(digits) <= primary {
+		return digits
+	}
+	if secondary <= 0 {
+		secondary = primary
+	}
+
+	groups := []string{digits[len(digits)-primary:]}
+	digits = digits[:len(digits)-primary]
+
+	for len(digits) > secondary {
+		groups = append(groups, digits[len(digits)-secondary:])
+		digits = digits[:len(digits)-secondary]
+	}
+	groups = append(groups, digits)
+
+	// Reverse the groups to restore the original order
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+
+	return strings.Join(groups, ",")
+}
+
+// localizeDigits replaces the ASCII digits and placeholders of a formatted number
+// with the digits of the numbering system and the symbols of the locale
+func localizeDigits(str string, format numberFormat, system string) string {
+	digits, exists := numberingSystemDigits[system]
+	if !exists {
+		digits = numberingSystemDigits[NumberingSystemLatin]
+	}
+
+	var result strings.Builder
+	for _, r := range str {
+		switch {
+		case r >= '0' && r <= '9':
+			result.WriteRune(digits[r-'0'])
+		case r == ',':
+			result.WriteString(format.group)
+		case r == '.':
+			result.WriteString(format.decimal)
+		case r == 'E':
+			result.WriteString(format.exponential)
+		case r == '-':
+			result.WriteString(format.minusSign)
+		default:
+			result.WriteRune(r)
+		}
+	}
+
+	return result.String()
+}
@@ -18,9 +18,11 @@ func Hex(opts ...OptionFunc) string {
 		o.hexMin, o.hexMax = o.hexMax, o.hexMin
 	}
 
-	// Generate a random number in the range
+	// Generate a random number in the range, or a boundary value if edge cases are enabled
 	var value int
-	if o.hexMin == o.hexMax {
+	if edgeValue, ok := edgeCaseInt(o, o.hexMin, o.hexMax, nil); ok {
+		value = edgeValue
+	} else if o.hexMin == o.hexMax {
 		value = o.hexMin
 	} else {
 		value = o.hexMin + random.IntN(o.hexMax-o.hexMin+1)
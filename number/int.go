@@ -3,12 +3,78 @@ package number
 import (
 	"github.com/khchehab/muzayaf/random"
 	"math"
+	"math/big"
+	"slices"
 )
 
 // Int generates a random integer based on the provided options
+// Odd, even, divisible-by and multiple constraints are met by picking among the matching values of the range,
+// while exclusions and predicates are met by sampling; if no value satisfying them is found, the range minimum
+// (adjusted to the multiple, as without constraints) is returned
 func Int(opts ...OptionFunc) int {
 	o := applyOptions(opts)
+	adjustIntRange(&o)
 
+	if o.intEmpty {
+		return o.intFallback
+	}
+
+	// Pick a boundary value if edge cases are enabled
+	if value, ok := edgeCaseInt(o, o.intMin, o.intMax, o.acceptsIntInRange); ok {
+		return value
+	}
+
+	// Use sampling without replacement when values must be excluded or filtered
+	if o.hasIntConstraints() {
+		values := sampleInts(o, 1)
+		if len(values) == 0 {
+			return o.intFallback
+		}
+		return values[0]
+	}
+
+	// If min equals max, return min
+	if o.intMin == o.intMax {
+		return o.intMin
+	}
+
+	// Generate random number
+	if o.intStep > 1 {
+		// Calculate how many matching values are in the range
+		count := uint64(o.intMax-o.intMin)/uint64(o.intStep) + 1
+		// Generate a random index and convert to the actual value
+		return o.intMin + int(random.Uint64N(count))*o.intStep
+	}
+
+	// Handle the case when intMax is math.MaxInt to prevent overflow
+	if o.intMax == math.MaxInt {
+		// Use math.MaxInt-1 to prevent overflow when adding 1
+		return o.intMin + random.IntN(math.MaxInt-o.intMin)
+	}
+
+	// Standard case: generate a random number in the range
+	return o.intMin + random.IntN(o.intMax-o.intMin+1)
+}
+
+// Ints generates k distinct random integers based on the provided options, in random order
+// It samples without replacement using a lazy Fisher-Yates shuffle, so it needs no retry loops
+// If fewer than k values satisfy the options, all of them are returned; when the exclusions and predicates
+// reject almost every value of a huge range, the search gives up after a number of draws proportional to k
+// and returns the values found
+func Ints(k int, opts ...OptionFunc) []int {
+	o := applyOptions(opts)
+	adjustIntRange(&o)
+
+	if k <= 0 || o.intEmpty {
+		return []int{}
+	}
+
+	return sampleInts(o, k)
+}
+
+// adjustIntRange validates the integer range and adjusts it to the values matching the multiple
+// and the odd, even and divisible-by constraints, which are intStep apart from intMin
+func adjustIntRange(o *Option) {
 	// Validate options
 	if o.intMin > o.intMax {
 		o.intMin, o.intMax = o.intMax, o.intMin
@@ -29,26 +95,156 @@ func Int(opts ...OptionFunc) int {
 			o.intMin = o.intMax
 		}
 	}
+	o.intStep = o.intMultiple
+	o.intFallback = o.intMin
 
-	// If min equals max, return min
-	if o.intMin == o.intMax {
-		return o.intMin
+	if len(o.intCongruences) == 0 {
+		return
 	}
 
-	// Generate random number
-	if o.intMultiple > 1 {
-		// Calculate how many multiples are in the range
-		count := (o.intMax-o.intMin)/o.intMultiple + 1
-		// Generate a random index and convert to the actual value
-		return o.intMin + (random.IntN(count) * o.intMultiple)
+	// Combine the multiple with the congruences; those whose combined modulus would overflow
+	// are checked as predicates instead
+	residue, modulus := 0, o.intMultiple
+	predicates := slices.Clip(o.intPredicates)
+	for _, c := range o.intCongruences {
+		combinedResidue, combinedModulus, ok, overflow := combineCongruences(residue, modulus, c.residue, c.modulus)
+		switch {
+		case overflow:
+			predicates = append(predicates, c.matches)
+		case !ok:
+			o.intEmpty = true
+			return
+		default:
+			residue, modulus = combinedResidue, combinedModulus
+		}
 	}
+	o.intPredicates = predicates
 
-	// Handle the case when intMax is math.MaxInt to prevent overflow
-	if o.intMax == math.MaxInt {
-		// Use math.MaxInt-1 to prevent overflow when adding 1
-		return o.intMin + random.IntN(math.MaxInt-o.intMin)
+	// Move the bounds to the closest matching values inside the range
+	span := uint64(o.intMax) - uint64(o.intMin)
+	up := (uint64(floorMod(residue, modulus)) + uint64(modulus) - uint64(floorMod(o.intMin, modulus))) % uint64(modulus)
+	down := (uint64(floorMod(o.intMax, modulus)) + uint64(modulus) - uint64(floorMod(residue, modulus))) % uint64(modulus)
+	if up > span || down > span-up {
+		o.intEmpty = true
+		return
 	}
+	o.intMin = int(uint64(o.intMin) + up)
+	o.intMax = int(uint64(o.intMax) - down)
+	o.intStep = modulus
+}
 
-	// Standard case: generate a random number in the range
-	return o.intMin + random.IntN(o.intMax-o.intMin+1)
+// intCongruence restricts integers to the values congruent to residue modulo modulus
+type intCongruence struct {
+	residue int
+	modulus int
+}
+
+// matches reports whether a value satisfies the congruence
+func (c intCongruence) matches(value int) bool {
+	return floorMod(value, c.modulus) == floorMod(c.residue, c.modulus)
+}
+
+// combineCongruences returns the congruence of the values satisfying two congruences (Chinese remainder theorem)
+// ok is false if no value satisfies both, and overflow is true if the combined modulus does not fit in an int
+func combineCongruences(r1, m1, r2, m2 int) (residue, modulus int, ok, overflow bool) {
+	a1, a2 := big.NewInt(int64(r1)), big.NewInt(int64(r2))
+	n1, n2 := big.NewInt(int64(m1)), big.NewInt(int64(m2))
+
+	// Solve n1*t ≡ a2-a1 (mod n2), which has solutions only if gcd(n1, n2) divides a2-a1
+	x := new(big.Int)
+	g := new(big.Int).GCD(x, nil, n1, n2)
+	diff := new(big.Int).Sub(a2, a1)
+	if new(big.Int).Mod(diff, g).Sign() != 0 {
+		return 0, 0, false, false
+	}
+
+	lcm := new(big.Int).Mul(new(big.Int).Quo(n1, g), n2)
+	if !lcm.IsInt64() || lcm.Int64() > math.MaxInt {
+		return 0, 0, true, true
+	}
+
+	// x is the inverse of n1/g modulo n2/g, from gcd(n1, n2) = x*n1 + y*n2
+	t := new(big.Int).Mul(new(big.Int).Quo(diff, g), x)
+	t.Mod(t, new(big.Int).Quo(n2, g))
+	value := new(big.Int).Add(a1, t.Mul(t, n1))
+	value.Mod(value, lcm)
+
+	return int(value.Int64()), int(lcm.Int64()), true, false
+}
+
+// floorMod returns the remainder of the division of a by a positive modulus, between 0 and the modulus
+func floorMod(a, modulus int) int {
+	if r := a % modulus; r < 0 {
+		return r + modulus
+	}
+	return a % modulus
+}
+
+// hasIntConstraints reports whether any exclusions or predicates are set
+func (o Option) hasIntConstraints() bool {
+	return len(o.intExclude) > 0 || len(o.intPredicates) > 0
+}
+
+// acceptsInt reports whether a value passes the exclusions and predicates
+func (o Option) acceptsInt(value int) bool {
+	if _, excluded := o.intExclude[value]; excluded {
+		return false
+	}
+	for _, predicate := range o.intPredicates {
+		if !predicate(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// acceptsIntInRange reports whether a value of the adjusted range is a valid result,
+// meaning it is a whole number of steps away from the minimum and passes the exclusions and predicates
+func (o Option) acceptsIntInRange(value int) bool {
+	if o.intStep > 1 && (uint64(value)-uint64(o.intMin))%uint64(o.intStep) != 0 {
+		return false
+	}
+	return o.acceptsInt(value)
+}
+
+// sampleInts draws up to k distinct values from the adjusted range that pass the exclusions and predicates
+// The range is treated as a virtual array of indices that is shuffled lazily, tracking only the swapped entries
+// To bound the work when the constraints reject almost every value of a huge range, it gives up after a number
+// of draws proportional to k
+func sampleInts(o Option, k int) []int {
+	step := uint64(o.intStep)
+	// count is the number of values in the range; zero stands for 2^64 when the range spans every int
+	count := uint64(o.intMax-o.intMin)/step + 1
+
+	limit := uint64(k)*1000 + 1<<20
+	values := make([]int, 0, min(k, 1<<16))
+	swaps := make(map[uint64]uint64)
+
+	for i := uint64(0); len(values) < k && i < limit && (count == 0 || i < count); i++ {
+		// Pick a random index from the part of the array that hasn't been drawn yet
+		var j uint64
+		if count-i == 0 {
+			j = random.Uint64()
+		} else {
+			j = i + random.Uint64N(count-i)
+		}
+
+		drawn, ok := swaps[j]
+		if !ok {
+			drawn = j
+		}
+		if current, ok := swaps[i]; ok {
+			swaps[j] = current
+			delete(swaps, i)
+		} else {
+			swaps[j] = i
+		}
+
+		value := int(uint64(o.intMin) + drawn*step)
+		if !o.hasIntConstraints() || o.acceptsInt(value) {
+			values = append(values, value)
+		}
+	}
+
+	return values
 }
@@ -1,9 +1,52 @@
 // Package number provides functionality for generating random numbers of different types.
-// It supports integers, floats, binary, octal, hexadecimal, and Roman numerals with various options.
+// It supports integers, floats, binary, octal, hexadecimal, and Roman numerals with various options,
+// as well as locale-aware formatted numbers.
 package number
 
+import (
+	"github.com/khchehab/muzayaf/internal"
+)
+
 const (
 	binaryPrefix = "0b"
 	octalPrefix  = "0"
 	hexPrefix    = "0x"
 )
+
+const (
+	// FormatStyleDecimal formats numbers as plain grouped decimals (e.g., 1,234,567.89)
+	FormatStyleDecimal = "decimal"
+	// FormatStylePercent formats numbers as percentages, multiplying the value by 100 (e.g., 12.34%)
+	FormatStylePercent = "percent"
+	// FormatStyleScientific formats numbers in scientific notation (e.g., 1.23E6)
+	FormatStyleScientific = "scientific"
+
+	// RomanNotationStandard represents standard subtractive Roman numerals from 1 to 3999 (e.g., XIV)
+	RomanNotationStandard = "standard"
+	// RomanNotationAdditive represents additive-only Roman numerals from 1 to 3999, as used on clock faces (e.g., IIII)
+	RomanNotationAdditive = "additive"
+	// RomanNotationVinculum represents Roman numerals up to 3999999 where an overline multiplies by 1000 (e.g., V̅)
+	RomanNotationVinculum = "vinculum"
+	// RomanNotationApostrophus represents Roman numerals up to 399999 using apostrophus thousands (e.g., IↃↃ)
+	RomanNotationApostrophus = "apostrophus"
+
+	// NumberingSystemLatin represents the ASCII digits 0-9
+	NumberingSystemLatin = "latn"
+	// NumberingSystemArabic represents the Arabic-Indic digits ٠-٩
+	NumberingSystemArabic = "arab"
+	// NumberingSystemPersian represents the extended Arabic-Indic (Persian) digits ۰-۹
+	NumberingSystemPersian = "arabext"
+	// NumberingSystemDevanagari represents the Devanagari digits ०-९
+	NumberingSystemDevanagari = "deva"
+)
+
+var (
+	// fallbackValues holds the number format used when no locale data can be loaded
+	// The formats of the locales live in locales/<locale>/number/format.json
+	fallbackValues = map[string]numberFormat{
+		"en": {decimal: ".", group: ",", primaryGrouping: 3, secondaryGrouping: 3, minusSign: "-", percentSuffix: "%", exponential: "E", numberingSystem: NumberingSystemLatin},
+	}
+
+	// numberingSystemDigits maps each numbering system to its digits from zero to nine
+	numberingSystemDigits = internal.NumberingSystemDigits
+)
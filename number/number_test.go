@@ -1,8 +1,12 @@
 package number
 
 import (
+	"errors"
+	"math"
 	"math/rand/v2"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/khchehab/muzayaf/random"
 )
@@ -59,6 +63,151 @@ func TestInt(t *testing.T) {
 	}
 }
 
+// TestIntConstraints tests the Int function with exclusions and predicates
+func TestIntConstraints(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test with a predicate and a fixed seed
+	primeInt := Int(WithIntMin(1), WithIntMax(100), WithIntPrime())
+	expectedPrimeInt := 47
+
+	if primeInt != expectedPrimeInt {
+		t.Errorf("Int(WithIntMin(1), WithIntMax(100), WithIntPrime()) = %v, want %v", primeInt, expectedPrimeInt)
+	}
+
+	tests := []struct {
+		name   string
+		opts   []OptionFunc
+		accept func(int) bool
+	}{
+		{"exclude", []OptionFunc{WithIntExclude(2, 3, 4)}, func(n int) bool { return n != 2 && n != 3 && n != 4 }},
+		{"odd", []OptionFunc{WithIntOdd()}, func(n int) bool { return n%2 != 0 }},
+		{"even", []OptionFunc{WithIntEven()}, func(n int) bool { return n%2 == 0 }},
+		{"prime", []OptionFunc{WithIntPrime()}, func(n int) bool { return n == 2 || n == 3 || n == 5 || n == 7 }},
+		{"divisible by", []OptionFunc{WithIntDivisibleBy(3)}, func(n int) bool { return n%3 == 0 }},
+		{"combined", []OptionFunc{WithIntOdd(), WithIntExclude(1, 3)}, func(n int) bool { return n == 5 || n == 7 || n == 9 }},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			opts := append([]OptionFunc{WithIntMin(1), WithIntMax(10)}, tt.opts...)
+			if got := Int(opts...); got < 1 || got > 10 || !tt.accept(got) {
+				t.Errorf("Int(%s) = %v, which does not satisfy the constraints", tt.name, got)
+			}
+		}
+	}
+
+	// Test that the adjusted range minimum is returned when no value satisfies the constraints
+	fallbackTests := []struct {
+		name     string
+		opts     []OptionFunc
+		expected int
+	}{
+		{"even and odd", []OptionFunc{WithIntMin(1), WithIntMax(10), WithIntEven(), WithIntOdd()}, 1},
+		{"all excluded", []OptionFunc{WithIntMin(1), WithIntMax(3), WithIntExclude(1, 2, 3)}, 1},
+		{"no multiple of 10", []OptionFunc{WithIntMin(1), WithIntMax(5), WithIntDivisibleBy(10)}, 1},
+		{"multiple of 4, odd", []OptionFunc{WithIntMin(1), WithIntMax(20), WithIntMultiple(4), WithIntOdd()}, 4},
+	}
+	for _, tt := range fallbackTests {
+		if got := Int(tt.opts...); got != tt.expected {
+			t.Errorf("Int(%s) = %v, want %v", tt.name, got, tt.expected)
+		}
+	}
+	if got := Int(WithIntMin(1), WithIntMax(3), WithIntExclude(1, 3)); got != 2 {
+		t.Errorf("Int(WithIntExclude(1, 3)) = %v, want 2", got)
+	}
+
+	// Test that sparse arithmetic constraints pick among the matching values of huge ranges
+	arithmeticTests := []struct {
+		name   string
+		opts   []OptionFunc
+		accept func(int) bool
+	}{
+		{"divisible by 10^13", []OptionFunc{WithIntDivisibleBy(10_000_000_000_000)}, func(n int) bool { return n%10_000_000_000_000 == 0 }},
+		{"divisible by -10^13", []OptionFunc{WithIntDivisibleBy(-10_000_000_000_000)}, func(n int) bool { return n%10_000_000_000_000 == 0 }},
+		{"odd multiple of 10^12+1", []OptionFunc{WithIntMultiple(1_000_000_000_001), WithIntOdd()}, func(n int) bool { return n%1_000_000_000_001 == 0 && n%2 != 0 }},
+		{"divisible by 6 and 10^12", []OptionFunc{WithIntDivisibleBy(6), WithIntDivisibleBy(1_000_000_000_000), WithIntExclude(0)}, func(n int) bool { return n%3_000_000_000_000 == 0 && n != 0 }},
+		{"odd, negative range", []OptionFunc{WithIntMin(math.MinInt), WithIntMax(-1), WithIntOdd()}, func(n int) bool { return n < 0 && n%2 != 0 }},
+		{"divisible by 2^62, full range", []OptionFunc{WithIntMin(math.MinInt), WithIntMax(math.MaxInt), WithIntDivisibleBy(1 << 62)}, func(n int) bool { return n%(1<<62) == 0 }},
+	}
+	for _, tt := range arithmeticTests {
+		for i := 0; i < 100; i++ {
+			if got := Int(tt.opts...); !tt.accept(got) {
+				t.Fatalf("Int(%s) = %v, which does not satisfy the constraints", tt.name, got)
+			}
+		}
+	}
+}
+
+// TestInts tests the Ints function
+func TestInts(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	ints := Ints(5, WithIntMin(1), WithIntMax(100))
+	expectedInts := []int{75, 25, 1, 88, 77}
+
+	if !slices.Equal(ints, expectedInts) {
+		t.Errorf("Ints(5, WithIntMin(1), WithIntMax(100)) = %v, want %v", ints, expectedInts)
+	}
+
+	// Test that a large sample contains only distinct values in the range
+	distinct := Ints(10000, WithIntMin(1), WithIntMax(20000))
+	seen := make(map[int]bool, len(distinct))
+	for _, value := range distinct {
+		if value < 1 || value > 20000 || seen[value] {
+			t.Fatalf("Ints(10000, WithIntMin(1), WithIntMax(20000)) returned %v more than once or out of range", value)
+		}
+		seen[value] = true
+	}
+	if len(distinct) != 10000 {
+		t.Errorf("Ints(10000, WithIntMin(1), WithIntMax(20000)) returned %v values, want %v", len(distinct), 10000)
+	}
+
+	// Test that the whole range is returned when it holds fewer than k values
+	all := Ints(20, WithIntMin(1), WithIntMax(10), WithIntExclude(3, 4))
+	slices.Sort(all)
+	expectedAll := []int{1, 2, 5, 6, 7, 8, 9, 10}
+
+	if !slices.Equal(all, expectedAll) {
+		t.Errorf("Ints(20, WithIntMin(1), WithIntMax(10), WithIntExclude(3, 4)) = %v, want %v", all, expectedAll)
+	}
+
+	// Test that an impossible combination returns no value, and that a search that gives up returns the values found
+	if values := Ints(5, WithIntMin(1), WithIntMax(10), WithIntEven(), WithIntOdd()); len(values) != 0 {
+		t.Errorf("Ints(5, WithIntEven(), WithIntOdd()) = %v, want no value", values)
+	}
+	sparse := WithIntPredicate(func(n int) bool { return n == 0 })
+	if values := Ints(1, WithIntMin(math.MinInt), WithIntMax(math.MaxInt), sparse); len(values) != 0 {
+		t.Errorf("Ints(1, WithIntPredicate(n == 0)) = %v, want no value", values)
+	}
+}
+
+// TestIsPrime tests the isPrime function
+func TestIsPrime(t *testing.T) {
+	count := 0
+	for n := -10; n < 100000; n++ {
+		if isPrime(n) {
+			count++
+		}
+	}
+
+	// There are 9592 primes below 100000
+	if count != 9592 {
+		t.Errorf("isPrime found %v primes below 100000, want %v", count, 9592)
+	}
+
+	if !isPrime(9223372036854775783) {
+		t.Errorf("isPrime(9223372036854775783) = false, want true")
+	}
+
+	if isPrime(math.MaxInt) {
+		t.Errorf("isPrime(math.MaxInt) = true, want false")
+	}
+}
+
 // TestFloat tests the Float function
 func TestFloat(t *testing.T) {
 	setupTest(t)
@@ -241,6 +390,562 @@ func TestRoman(t *testing.T) {
 	}
 }
 
+// TestFormatted tests the Formatted function
+func TestFormatted(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test multiple calls to ensure reproducibility
+	formatted1 := Formatted()
+	formatted2 := Formatted()
+
+	// With a fixed seed, we should get consistent results
+	expectedFormatted1 := "405,105.45"
+	expectedFormatted2 := "517,855.08"
+
+	if formatted1 != expectedFormatted1 {
+		t.Errorf("Formatted() = %v, want %v", formatted1, expectedFormatted1)
+	}
+
+	if formatted2 != expectedFormatted2 {
+		t.Errorf("Formatted() second call = %v, want %v", formatted2, expectedFormatted2)
+	}
+
+	// Test locale conventions with a fixed value
+	tests := []struct {
+		name     string
+		opts     []OptionFunc
+		expected string
+	}{
+		{"en", []OptionFunc{WithLocale("en")}, "1,234,567.89"},
+		{"de", []OptionFunc{WithLocale("de")}, "1.234.567,89"},
+		{"ar", []OptionFunc{WithLocale("ar")}, "١٬٢٣٤٬٥٦٧٫٨٩"},
+		{"fa", []OptionFunc{WithLocale("fa")}, "۱٬۲۳۴٬۵۶۷٫۸۹"},
+		{"hi", []OptionFunc{WithLocale("hi")}, "12,34,567.89"},
+		{"hi devanagari", []OptionFunc{WithLocale("hi"), WithFormattedNumberingSystem(NumberingSystemDevanagari)}, "१२,३४,५६७.८९"},
+		{"non-existent", []OptionFunc{WithLocale("non-existent")}, "1,234,567.89"},
+		{"path", []OptionFunc{WithLocale("../locales/de")}, "1,234,567.89"},
+		{"no grouping", []OptionFunc{WithFormattedGrouping(false)}, "1234567.89"},
+		{"no fraction", []OptionFunc{WithFormattedFractionDigits(0)}, "1,234,568"},
+		{"scientific", []OptionFunc{WithFormattedStyle(FormatStyleScientific)}, "1.23E6"},
+		{"scientific de", []OptionFunc{WithLocale("de"), WithFormattedStyle(FormatStyleScientific)}, "1,23E6"},
+	}
+
+	for _, tt := range tests {
+		opts := append([]OptionFunc{WithFormattedMin(1234567.891), WithFormattedMax(1234567.891)}, tt.opts...)
+		if got := Formatted(opts...); got != tt.expected {
+			t.Errorf("Formatted(%s) = %v, want %v", tt.name, got, tt.expected)
+		}
+	}
+
+	// Test percent style with a negative value
+	percent := Formatted(WithFormattedMin(-0.1234), WithFormattedMax(-0.1234), WithFormattedStyle(FormatStylePercent))
+	expectedPercent := "-12.34%"
+
+	if percent != expectedPercent {
+		t.Errorf("Formatted(WithFormattedStyle(FormatStylePercent)) = %v, want %v", percent, expectedPercent)
+	}
+
+	// Test percent style with the Arabic locale
+	arabicPercent := Formatted(WithLocale("ar"), WithFormattedMin(0.5), WithFormattedMax(0.5), WithFormattedStyle(FormatStylePercent))
+	expectedArabicPercent := "٥٠٫٠٠٪\u061c"
+
+	if arabicPercent != expectedArabicPercent {
+		t.Errorf("Formatted(WithLocale(\"ar\"), WithFormattedStyle(FormatStylePercent)) = %v, want %v", arabicPercent, expectedArabicPercent)
+	}
+
+	// Test scientific style with a negative exponent
+	scientific := Formatted(WithFormattedMin(0.000123), WithFormattedMax(0.000123), WithFormattedStyle(FormatStyleScientific))
+	expectedScientific := "1.23E-4"
+
+	if scientific != expectedScientific {
+		t.Errorf("Formatted(WithFormattedStyle(FormatStyleScientific)) = %v, want %v", scientific, expectedScientific)
+	}
+}
+
+// TestRomanNotations tests the Roman function with the notation, lowercase and unicode options
+func TestRomanNotations(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	tests := []struct {
+		name     string
+		value    int
+		opts     []OptionFunc
+		expected string
+	}{
+		{"standard", 1994, nil, "MCMXCIV"},
+		{"lowercase", 14, []OptionFunc{WithRomanLowercase(true)}, "xiv"},
+		{"unicode", 14, []OptionFunc{WithRomanUnicode(true)}, "ⅩⅠⅤ"},
+		{"unicode lowercase", 14, []OptionFunc{WithRomanUnicode(true), WithRomanLowercase(true)}, "ⅹⅰⅴ"},
+		{"additive", 4, []OptionFunc{WithRomanNotation(RomanNotationAdditive)}, "IIII"},
+		{"additive 1999", 1999, []OptionFunc{WithRomanNotation(RomanNotationAdditive)}, "MDCCCCLXXXXVIIII"},
+		{"standard clamped", 5000, nil, "MMMCMXCIX"},
+		{"vinculum", 4000, []OptionFunc{WithRomanNotation(RomanNotationVinculum)}, "I\u0305V\u0305"},
+		{"vinculum below 4000", 3999, []OptionFunc{WithRomanNotation(RomanNotationVinculum)}, "MMMCMXCIX"},
+		{"vinculum large", 1500042, []OptionFunc{WithRomanNotation(RomanNotationVinculum)}, "M\u0305D\u0305XLII"},
+		{"apostrophus", 16001, []OptionFunc{WithRomanNotation(RomanNotationApostrophus)}, "CCIↃↃIↃↃCIↃI"},
+		{"apostrophus unicode", 16001, []OptionFunc{WithRomanNotation(RomanNotationApostrophus), WithRomanUnicode(true)}, "ↂↁↀⅠ"},
+		{"apostrophus lowercase", 1000, []OptionFunc{WithRomanNotation(RomanNotationApostrophus), WithRomanLowercase(true)}, "ciↄ"},
+	}
+
+	for _, tt := range tests {
+		opts := append([]OptionFunc{WithRomanMin(tt.value), WithRomanMax(tt.value)}, tt.opts...)
+		if got := Roman(opts...); got != tt.expected {
+			t.Errorf("Roman(%s) = %v, want %v", tt.name, got, tt.expected)
+		}
+	}
+
+	// Test that every notation round-trips through ParseRoman
+	for _, notation := range []string{RomanNotationStandard, RomanNotationAdditive, RomanNotationVinculum, RomanNotationApostrophus} {
+		for i := 0; i < 100; i++ {
+			roman := Roman(WithRomanNotation(notation), WithRomanMax(math.MaxInt), WithRomanLowercase(i%2 == 0), WithRomanUnicode(i%3 == 0))
+			if _, err := ParseRoman(roman); err != nil {
+				t.Errorf("ParseRoman(Roman(WithRomanNotation(%q))) returned error for %q: %v", notation, roman, err)
+			}
+		}
+	}
+}
+
+// TestParseRoman tests the ParseRoman function
+func TestParseRoman(t *testing.T) {
+	validTests := []struct {
+		input    string
+		expected int
+	}{
+		{"I", 1},
+		{"XIV", 14},
+		{"MCMXCIV", 1994},
+		{"MMMCMXCIX", 3999},
+		{"xiv", 14},
+		{"ⅩⅠⅤ", 14},
+		{"ⅹⅰⅴ", 14},
+		{"IIII", 4},
+		{"MDCCCCLXXXXVIIII", 1999},
+		{"I\u0305V\u0305", 4000},
+		{"M\u0305D\u0305XLII", 1500042},
+		{"CCIↃↃIↃↃCIↃI", 16001},
+		{"ↂↁↀⅠ", 16001},
+		{"ciↄ", 1000},
+	}
+
+	for _, tt := range validTests {
+		got, err := ParseRoman(tt.input)
+		if err != nil {
+			t.Errorf("ParseRoman(%q) returned error: %v", tt.input, err)
+		} else if got != tt.expected {
+			t.Errorf("ParseRoman(%q) = %v, want %v", tt.input, got, tt.expected)
+		}
+	}
+
+	// Test that non-canonical and malformed numerals are rejected
+	invalidTests := []string{"", "IIX", "IC", "XM", "VV", "IIIII", "MMMM", "XiV", "ABC", "Ⅻ", "I\u0305"}
+
+	for _, input := range invalidTests {
+		if got, err := ParseRoman(input); !errors.Is(err, ErrInvalidRoman) {
+			t.Errorf("ParseRoman(%q) = %v, %v, want ErrInvalidRoman", input, got, err)
+		}
+	}
+
+	// Test round-trips for every standard value
+	for value := 1; value <= 3999; value++ {
+		roman := Roman(WithRomanMin(value), WithRomanMax(value))
+		if got, err := ParseRoman(roman); err != nil || got != value {
+			t.Errorf("ParseRoman(%q) = %v, %v, want %v", roman, got, err, value)
+		}
+	}
+}
+
+// TestEdgeCases tests the WithEdgeCaseProbability option
+func TestEdgeCases(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	intTests := []struct {
+		name     string
+		generate func() int
+		expected []int
+	}{
+		{"Int", func() int { return Int(WithEdgeCaseProbability(1)) }, []int{0, 1, math.MaxInt - 1, math.MaxInt}},
+		{"Int range", func() int { return Int(WithIntMin(-5), WithIntMax(5), WithEdgeCaseProbability(1)) }, []int{-5, -4, -1, 0, 1, 4, 5}},
+		{"Int multiple", func() int { return Int(WithIntMin(0), WithIntMax(100), WithIntMultiple(7), WithEdgeCaseProbability(1)) }, []int{0, 98}},
+		{"Int exclude", func() int { return Int(WithIntMin(10), WithIntMax(20), WithIntExclude(10), WithEdgeCaseProbability(1)) }, []int{11, 19, 20}},
+	}
+
+	for _, tt := range intTests {
+		seen := make(map[int]bool)
+		for i := 0; i < 200; i++ {
+			value := tt.generate()
+			if !slices.Contains(tt.expected, value) {
+				t.Errorf("%s with edge cases = %v, want one of %v", tt.name, value, tt.expected)
+			}
+			seen[value] = true
+		}
+		if len(seen) != len(tt.expected) {
+			t.Errorf("%s with edge cases produced %v distinct values, want %v", tt.name, len(seen), len(tt.expected))
+		}
+	}
+
+	// Test the base-N generators
+	for i := 0; i < 100; i++ {
+		if hex := Hex(WithEdgeCaseProbability(1)); !slices.Contains([]string{"0", "1", "e", "f"}, hex) {
+			t.Errorf("Hex(WithEdgeCaseProbability(1)) = %v, want one of 0, 1, e, f", hex)
+		}
+		if binary := Binary(WithBinaryMin(4), WithBinaryMax(8), WithEdgeCaseProbability(1)); !slices.Contains([]string{"100", "101", "111", "1000"}, binary) {
+			t.Errorf("Binary(WithEdgeCaseProbability(1)) = %v, want one of 100, 101, 111, 1000", binary)
+		}
+		if octal := Octal(WithOctalMin(10), WithOctalMax(20), WithEdgeCaseProbability(1)); !slices.Contains([]string{"12", "13", "23", "24"}, octal) {
+			t.Errorf("Octal(WithEdgeCaseProbability(1)) = %v, want one of 12, 13, 23, 24", octal)
+		}
+	}
+
+	// Test that Float produces the special values, NaN and ±Inf only on request in a finite range
+	for i := 0; i < 500; i++ {
+		if value := Float(WithFloatMin(-1), WithFloatMax(1), WithEdgeCaseProbability(1)); math.IsNaN(value) || value < -1 || value > 1 {
+			t.Fatalf("Float(WithFloatMin(-1), WithFloatMax(1), WithEdgeCaseProbability(1)) = %v, want a value in [-1, 1]", value)
+		}
+		if value := Float(WithFloatMin(0), WithFloatMax(math.Inf(1)), WithEdgeCaseProbability(1)); math.IsNaN(value) || value < 0 {
+			t.Fatalf("Float(WithFloatMax(+Inf), WithEdgeCaseProbability(1)) = %v, want a value in [0, +Inf]", value)
+		}
+	}
+
+	var nan, posInf, negInf, negZero, subnormal bool
+	for i := 0; i < 500; i++ {
+		value := Float(WithFloatMin(-1), WithFloatMax(1), WithEdgeCaseProbability(1), WithEdgeCaseNonFinite(true))
+		switch {
+		case math.IsNaN(value):
+			nan = true
+		case math.IsInf(value, 1):
+			posInf = true
+		case math.IsInf(value, -1):
+			negInf = true
+		case value == 0 && math.Signbit(value):
+			negZero = true
+		case value != 0 && math.Abs(value) < math.SmallestNonzeroFloat64*2:
+			subnormal = true
+		case value < -1 || value > 1:
+			t.Errorf("Float(WithEdgeCaseProbability(1)) = %v, which is outside [-1, 1]", value)
+		}
+	}
+	if !nan || !posInf || !negInf || !negZero || !subnormal {
+		t.Errorf("Float(WithEdgeCaseProbability(1)) missed special values: NaN %v, +Inf %v, -Inf %v, -0.0 %v, subnormal %v",
+			nan, posInf, negInf, negZero, subnormal)
+	}
+
+	nan, posInf, negInf = false, false, false
+	for i := 0; i < 500; i++ {
+		switch value := Float(WithFloatMin(math.Inf(-1)), WithFloatMax(math.Inf(1)), WithEdgeCaseProbability(1)); {
+		case math.IsNaN(value):
+			nan = true
+		case math.IsInf(value, 1):
+			posInf = true
+		case math.IsInf(value, -1):
+			negInf = true
+		}
+	}
+	if !nan || !posInf || !negInf {
+		t.Errorf("Float(unbounded range, WithEdgeCaseProbability(1)) missed special values: NaN %v, +Inf %v, -Inf %v", nan, posInf, negInf)
+	}
+
+	// Test that a zero probability never produces edge cases
+	for i := 0; i < 100; i++ {
+		if value := Float(WithEdgeCaseProbability(0)); math.IsNaN(value) || math.IsInf(value, 0) {
+			t.Errorf("Float(WithEdgeCaseProbability(0)) = %v, want a finite value", value)
+		}
+	}
+}
+
+// TestSequence tests the Sequence type
+func TestSequence(t *testing.T) {
+	// Test the default sequence
+	sequence := NewSequence()
+	if values := sequence.Take(3); !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("NewSequence().Take(3) = %v, want %v", values, []int{1, 2, 3})
+	}
+
+	// Test with start and step options
+	stepped := NewSequence(WithSequenceStart(100), WithSequenceStep(10))
+	if value := stepped.Next(); value != 100 {
+		t.Errorf("NewSequence(WithSequenceStart(100)).Next() = %v, want %v", value, 100)
+	}
+	if value := stepped.Next(); value != 110 {
+		t.Errorf("NewSequence(WithSequenceStep(10)).Next() second call = %v, want %v", value, 110)
+	}
+
+	// Test with a negative step
+	countdown := NewSequence(WithSequenceStart(3), WithSequenceStep(-1))
+	if values := countdown.Take(3); !slices.Equal(values, []int{3, 2, 1}) {
+		t.Errorf("NewSequence(WithSequenceStep(-1)).Take(3) = %v, want %v", values, []int{3, 2, 1})
+	}
+}
+
+// TestSortedInts tests the SortedInts function
+func TestSortedInts(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	sorted := SortedInts(5, WithIntMin(1), WithIntMax(100))
+	expectedSorted := []int{1, 25, 75, 77, 88}
+
+	if !slices.Equal(sorted, expectedSorted) {
+		t.Errorf("SortedInts(5, WithIntMin(1), WithIntMax(100)) = %v, want %v", sorted, expectedSorted)
+	}
+}
+
+// TestSnowflake tests the Snowflake type
+func TestSnowflake(t *testing.T) {
+	epoch := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	generator := NewSnowflake(WithSnowflakeEpoch(epoch), WithSnowflakeWorker(5))
+
+	// Test the ID layout
+	id := generator.NextAt(at)
+	expectedID := at.Sub(epoch).Milliseconds()<<22 | 5<<12
+
+	if id != expectedID {
+		t.Errorf("NextAt(%v) = %v, want %v", at, id, expectedID)
+	}
+	if got := generator.Time(id); !got.Equal(at) {
+		t.Errorf("Time(%v) = %v, want %v", id, got, at)
+	}
+	if got := generator.Worker(id); got != 5 {
+		t.Errorf("Worker(%v) = %v, want %v", id, got, 5)
+	}
+
+	// Test that IDs in the same millisecond increment the sequence
+	next := generator.NextAt(at)
+	if next != id+1 || generator.Sequence(next) != 1 {
+		t.Errorf("NextAt(%v) second call = %v, want %v", at, next, id+1)
+	}
+
+	// Test that IDs stay ordered when the time goes backwards
+	if earlier := generator.NextAt(at.Add(-time.Hour)); earlier <= next {
+		t.Errorf("NextAt(%v) = %v, want more than %v", at.Add(-time.Hour), earlier, next)
+	}
+
+	// Test that an exhausted sequence moves to the next millisecond
+	small := NewSnowflake(WithSnowflakeEpoch(epoch), WithSnowflakeWorkerBits(0), WithSnowflakeSequenceBits(1))
+	first := small.NextAt(at)
+	small.NextAt(at)
+	third := small.NextAt(at)
+
+	if got := small.Time(third).Sub(small.Time(first)); got != time.Millisecond {
+		t.Errorf("exhausted sequence moved the time by %v, want %v", got, time.Millisecond)
+	}
+
+	// Test that timestamps past the timestamp bits are clamped instead of overflowing into the sign or worker bits
+	early := NewSnowflake(WithSnowflakeEpoch(time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)), WithSnowflakeWorker(5), WithSnowflakeWorkerBits(21), WithSnowflakeSequenceBits(1))
+	last := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC).Add((1<<41 - 1) * time.Millisecond)
+	previous := int64(0)
+	for i := 0; i < 4; i++ {
+		id := early.NextAt(at)
+		if id < previous || early.Worker(id) != 5 || !early.Time(id).Equal(last) {
+			t.Errorf("NextAt(%v) with an epoch in 1900 = %v (time %v, worker %v), want a time of %v and worker 5 after %v",
+				at, id, early.Time(id), early.Worker(id), last, previous)
+		}
+		previous = id
+	}
+	if id := early.NextAt(at); id != previous {
+		t.Errorf("NextAt(%v) with an exhausted last millisecond = %v, want the largest ID %v", at, id, previous)
+	}
+
+	wide := NewSnowflake(WithSnowflakeEpoch(epoch), WithSnowflakeWorkerBits(0), WithSnowflakeSequenceBits(1))
+	future := time.Date(2500, 1, 1, 0, 0, 0, 0, time.UTC)
+	if id := wide.NextAt(future); id < 0 || wide.Time(id).Before(epoch.Add(250*365*24*time.Hour)) {
+		t.Errorf("NextAt(%v) with 62 timestamp bits = %v (time %v), want a positive ID about 292 years after the epoch", future, id, wide.Time(id))
+	}
+}
+
+// TestSnowflakeIDs tests the SnowflakeIDs function
+func TestSnowflakeIDs(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	ids := SnowflakeIDs(100, from, to, WithSnowflakeWorker(7))
+	generator := NewSnowflake(WithSnowflakeWorker(7))
+
+	if len(ids) != 100 {
+		t.Fatalf("SnowflakeIDs(100) returned %v IDs, want %v", len(ids), 100)
+	}
+
+	for i, id := range ids {
+		if i > 0 && id <= ids[i-1] {
+			t.Errorf("SnowflakeIDs(100) is not strictly increasing at index %v: %v <= %v", i, id, ids[i-1])
+		}
+		if generated := generator.Time(id); generated.Before(from) || generated.After(to) {
+			t.Errorf("SnowflakeIDs(100) embedded time %v, want between %v and %v", generated, from, to)
+		}
+		if worker := generator.Worker(id); worker != 7 {
+			t.Errorf("SnowflakeIDs(100) embedded worker %v, want %v", worker, 7)
+		}
+	}
+}
+
+// TestVector tests the Vector function
+func TestVector(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	vector := Vector(3)
+	expectedVector := []float64{0.40510544537896787, 0.5178550802041214, 0.6395148186622913}
+
+	if !slices.Equal(vector, expectedVector) {
+		t.Errorf("Vector(3) = %v, want %v", vector, expectedVector)
+	}
+
+	// Test with min and max options
+	for _, value := range Vector(100, WithVectorMin(-5), WithVectorMax(5)) {
+		if value < -5 || value >= 5 {
+			t.Errorf("Vector(100, WithVectorMin(-5), WithVectorMax(5)) returned %v", value)
+		}
+	}
+}
+
+// TestGaussianVector tests the GaussianVector function
+func TestGaussianVector(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	vector := GaussianVector(2)
+	expectedVector := []float64{-0.6570890145999749, -0.5806540886906191}
+
+	if !slices.Equal(vector, expectedVector) {
+		t.Errorf("GaussianVector(2) = %v, want %v", vector, expectedVector)
+	}
+
+	// Test the sample mean and standard deviation
+	samples := GaussianVector(100000, WithVectorMean(10), WithVectorStdDev(2))
+	var sum, sumSquares float64
+	for _, value := range samples {
+		sum += value
+		sumSquares += value * value
+	}
+	mean := sum / float64(len(samples))
+	stdDev := math.Sqrt(sumSquares/float64(len(samples)) - mean*mean)
+
+	if math.Abs(mean-10) > 0.05 || math.Abs(stdDev-2) > 0.05 {
+		t.Errorf("GaussianVector(WithVectorMean(10), WithVectorStdDev(2)) has mean %v and standard deviation %v", mean, stdDev)
+	}
+}
+
+// TestUnitVector tests the UnitVector function
+func TestUnitVector(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	for _, n := range []int{1, 3, 768} {
+		var sumSquares float64
+		for _, value := range UnitVector(n) {
+			sumSquares += value * value
+		}
+		if math.Abs(math.Sqrt(sumSquares)-1) > 1e-12 {
+			t.Errorf("UnitVector(%v) has length %v, want 1", n, math.Sqrt(sumSquares))
+		}
+	}
+}
+
+// TestMatrix tests the Matrix, SymmetricMatrix and PositiveDefiniteMatrix functions
+func TestMatrix(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test the dimensions of a general matrix
+	matrix := Matrix(2, 3, WithVectorMin(1), WithVectorMax(2))
+	if len(matrix) != 2 || len(matrix[0]) != 3 || len(matrix[1]) != 3 {
+		t.Errorf("Matrix(2, 3) = %v, want a 2x3 matrix", matrix)
+	}
+
+	// Test that the symmetric matrix equals its transpose
+	symmetric := SymmetricMatrix(5)
+	for i := range symmetric {
+		for j := range symmetric {
+			if symmetric[i][j] != symmetric[j][i] {
+				t.Errorf("SymmetricMatrix(5)[%v][%v] = %v, want %v", i, j, symmetric[i][j], symmetric[j][i])
+			}
+		}
+	}
+
+	// Test that the positive-definite matrix is symmetric and has a Cholesky decomposition
+	positiveDefinite := PositiveDefiniteMatrix(6, WithVectorMin(-1), WithVectorMax(1))
+	n := len(positiveDefinite)
+	lower := make([][]float64, n)
+	for i := range lower {
+		lower[i] = make([]float64, n)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= i; j++ {
+			if positiveDefinite[i][j] != positiveDefinite[j][i] {
+				t.Fatalf("PositiveDefiniteMatrix(6) is not symmetric at [%v][%v]", i, j)
+			}
+
+			sum := positiveDefinite[i][j]
+			for k := 0; k < j; k++ {
+				sum -= lower[i][k] * lower[j][k]
+			}
+			if i == j {
+				if sum <= 0 {
+					t.Fatalf("PositiveDefiniteMatrix(6) is not positive-definite: pivot %v is %v", i, sum)
+				}
+				lower[i][i] = math.Sqrt(sum)
+			} else {
+				lower[i][j] = sum / lower[j][j]
+			}
+		}
+	}
+}
+
+// TestProportions tests the Proportions function
+func TestProportions(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// With a fixed seed, we should get consistent results
+	proportions := Proportions(4, WithProportionsTotal(1000), WithProportionsFractionDigits(2))
+	expectedProportions := []float64{115.24, 67.65, 769.71, 47.4}
+
+	if !slices.Equal(proportions, expectedProportions) {
+		t.Errorf("Proportions(4, WithProportionsTotal(1000), WithProportionsFractionDigits(2)) = %v, want %v", proportions, expectedProportions)
+	}
+
+	// Test that the proportions sum exactly to the total
+	for i := 0; i < 1000; i++ {
+		k := 1 + i%10
+		var sum float64
+		for _, p := range Proportions(k, WithProportionsConcentration(0.5+float64(i%3))) {
+			if p < 0 {
+				t.Errorf("Proportions(%v) returned a negative proportion %v", k, p)
+			}
+			sum += p
+		}
+		if sum != 1 {
+			t.Errorf("Proportions(%v) sum to %v, want 1", k, sum)
+		}
+	}
+
+	// Test that the rounded proportions sum exactly to the total in cents
+	for i := 0; i < 1000; i++ {
+		var cents int64
+		for _, p := range Proportions(7, WithProportionsTotal(99.99), WithProportionsFractionDigits(2)) {
+			cents += int64(math.Round(p * 100))
+		}
+		if cents != 9999 {
+			t.Errorf("Proportions(7, WithProportionsTotal(99.99), WithProportionsFractionDigits(2)) sum to %v cents, want 9999", cents)
+		}
+	}
+
+	// Test with a non-positive k
+	if empty := Proportions(0); len(empty) != 0 {
+		t.Errorf("Proportions(0) = %v, want an empty slice", empty)
+	}
+}
+
 // BenchmarkInt benchmarks the Int function
 func BenchmarkInt(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -248,6 +953,13 @@ func BenchmarkInt(b *testing.B) {
 	}
 }
 
+// BenchmarkInts benchmarks the Ints function
+func BenchmarkInts(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Ints(100, WithIntMin(1), WithIntMax(10000))
+	}
+}
+
 // BenchmarkFloat benchmarks the Float function
 func BenchmarkFloat(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -282,3 +994,32 @@ func BenchmarkRoman(b *testing.B) {
 		Roman()
 	}
 }
+
+// BenchmarkFormatted benchmarks the Formatted function
+func BenchmarkFormatted(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Formatted()
+	}
+}
+
+// BenchmarkParseRoman benchmarks the ParseRoman function
+func BenchmarkParseRoman(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseRoman("MCMXCIV")
+	}
+}
+
+// BenchmarkSnowflake benchmarks the Snowflake Next method
+func BenchmarkSnowflake(b *testing.B) {
+	generator := NewSnowflake()
+	for i := 0; i < b.N; i++ {
+		generator.Next()
+	}
+}
+
+// BenchmarkProportions benchmarks the Proportions function
+func BenchmarkProportions(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Proportions(10)
+	}
+}
@@ -17,9 +17,11 @@ func Octal(opts ...OptionFunc) string {
 		o.octalMin, o.octalMax = o.octalMax, o.octalMin
 	}
 
-	// Generate a random number in the range
+	// Generate a random number in the range, or a boundary value if edge cases are enabled
 	var value int
-	if o.octalMin == o.octalMax {
+	if edgeValue, ok := edgeCaseInt(o, o.octalMin, o.octalMax, nil); ok {
+		value = edgeValue
+	} else if o.octalMin == o.octalMax {
 		value = o.octalMin
 	} else {
 		value = o.octalMin + random.IntN(o.octalMax-o.octalMin+1)
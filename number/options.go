@@ -2,14 +2,29 @@ package number
 
 import (
 	"math"
+	"time"
 )
 
 // Option struct holds configuration for number generation
 type Option struct {
+	locale string
+
+	// Edge case options
+	edgeCaseProbability float64
+	edgeCaseNonFinite   bool
+
 	// Int options
-	intMin      int
-	intMax      int
-	intMultiple int
+	intMin         int
+	intMax         int
+	intMultiple    int
+	intExclude     map[int]struct{}
+	intPredicates  []func(int) bool
+	intCongruences []intCongruence
+
+	// Int range computed from the int options (see adjustIntRange)
+	intStep     int
+	intEmpty    bool
+	intFallback int
 
 	// Float options
 	floatMin            float64
@@ -32,8 +47,40 @@ type Option struct {
 	hexPrefix bool
 
 	// Roman numeral options
-	romanMin int
-	romanMax int
+	romanMin       int
+	romanMax       int
+	romanNotation  string
+	romanLowercase bool
+	romanUnicode   bool
+
+	// Formatted number options
+	formattedMin             float64
+	formattedMax             float64
+	formattedFractionDigits  int
+	formattedStyle           string
+	formattedGrouping        bool
+	formattedNumberingSystem string
+
+	// Vector and matrix options
+	vectorMin    float64
+	vectorMax    float64
+	vectorMean   float64
+	vectorStdDev float64
+
+	// Proportions options
+	proportionsTotal          float64
+	proportionsConcentration  float64
+	proportionsFractionDigits int
+
+	// Sequence options
+	sequenceStart int
+	sequenceStep  int
+
+	// Snowflake options
+	snowflakeEpoch        time.Time
+	snowflakeWorker       int64
+	snowflakeWorkerBits   int
+	snowflakeSequenceBits int
 }
 
 type OptionFunc func(*Option)
@@ -41,6 +88,11 @@ type OptionFunc func(*Option)
 // defaultOption returns the default configuration
 func defaultOption() Option {
 	return Option{
+		locale: "en",
+
+		// Edge case defaults
+		edgeCaseProbability: 0.0,
+
 		// Int defaults
 		intMin:      0,
 		intMax:      math.MaxInt,
@@ -67,8 +119,40 @@ func defaultOption() Option {
 		hexPrefix: false,
 
 		// Roman numeral defaults
-		romanMin: 1,
-		romanMax: 3999,
+		romanMin:       1,
+		romanMax:       3999,
+		romanNotation:  RomanNotationStandard,
+		romanLowercase: false,
+		romanUnicode:   false,
+
+		// Formatted number defaults
+		formattedMin:             0.0,
+		formattedMax:             1000000.0,
+		formattedFractionDigits:  2,
+		formattedStyle:           FormatStyleDecimal,
+		formattedGrouping:        true,
+		formattedNumberingSystem: "",
+
+		// Vector and matrix defaults
+		vectorMin:    0.0,
+		vectorMax:    1.0,
+		vectorMean:   0.0,
+		vectorStdDev: 1.0,
+
+		// Proportions defaults
+		proportionsTotal:          1.0,
+		proportionsConcentration:  1.0,
+		proportionsFractionDigits: -1,
+
+		// Sequence defaults
+		sequenceStart: 1,
+		sequenceStep:  1,
+
+		// Snowflake defaults
+		snowflakeEpoch:        defaultSnowflakeEpoch,
+		snowflakeWorker:       0,
+		snowflakeWorkerBits:   10,
+		snowflakeSequenceBits: 12,
 	}
 }
 
@@ -81,6 +165,37 @@ func applyOptions(opts []OptionFunc) Option {
 	return opt
 }
 
+// WithLocale sets the locale for locale-aware number generation
+func WithLocale(locale string) OptionFunc {
+	return func(o *Option) {
+		o.locale = locale
+	}
+}
+
+// WithEdgeCaseProbability sets the probability, from 0 to 1, of generating a boundary or special value
+// instead of a uniform random one, such as 0, -1, math.MaxInt, -0.0, subnormals or the range limits
+// It applies to Int, Float, Binary, Octal and Hex, and respects their configured ranges; ±Inf are included
+// when the float range reaches them and NaN when it is unbounded on both sides, unless WithEdgeCaseNonFinite is used
+func WithEdgeCaseProbability(probability float64) OptionFunc {
+	return func(o *Option) {
+		switch {
+		case probability > 1:
+			o.edgeCaseProbability = 1
+		case probability > 0:
+			o.edgeCaseProbability = probability
+		default:
+			o.edgeCaseProbability = 0
+		}
+	}
+}
+
+// WithEdgeCaseNonFinite sets whether the float edge cases always include NaN and ±Inf, even in a finite range
+func WithEdgeCaseNonFinite(include bool) OptionFunc {
+	return func(o *Option) {
+		o.edgeCaseNonFinite = include
+	}
+}
+
 // WithIntMin sets the minimum value for random integers
 func WithIntMin(min int) OptionFunc {
 	return func(o *Option) {
@@ -104,6 +219,62 @@ func WithIntMultiple(multiple int) OptionFunc {
 	}
 }
 
+// WithIntExclude excludes the given values from random integers
+// It can be applied multiple times to add more values
+func WithIntExclude(values ...int) OptionFunc {
+	return func(o *Option) {
+		if o.intExclude == nil {
+			o.intExclude = make(map[int]struct{}, len(values))
+		}
+		for _, value := range values {
+			o.intExclude[value] = struct{}{}
+		}
+	}
+}
+
+// WithIntPredicate restricts random integers to values that satisfy the predicate
+// It can be applied multiple times, in which case a value must satisfy all predicates
+func WithIntPredicate(predicate func(int) bool) OptionFunc {
+	return func(o *Option) {
+		if predicate != nil {
+			o.intPredicates = append(o.intPredicates, predicate)
+		}
+	}
+}
+
+// WithIntOdd restricts random integers to odd values
+func WithIntOdd() OptionFunc {
+	return func(o *Option) {
+		o.intCongruences = append(o.intCongruences, intCongruence{residue: 1, modulus: 2})
+	}
+}
+
+// WithIntEven restricts random integers to even values
+func WithIntEven() OptionFunc {
+	return func(o *Option) {
+		o.intCongruences = append(o.intCongruences, intCongruence{residue: 0, modulus: 2})
+	}
+}
+
+// WithIntPrime restricts random integers to prime values
+func WithIntPrime() OptionFunc {
+	return WithIntPredicate(isPrime)
+}
+
+// WithIntDivisibleBy restricts random integers to values divisible by the divisor
+func WithIntDivisibleBy(divisor int) OptionFunc {
+	return func(o *Option) {
+		switch {
+		case divisor == math.MinInt:
+			o.intPredicates = append(o.intPredicates, func(n int) bool {
+				return n%divisor == 0
+			})
+		case divisor != 0:
+			o.intCongruences = append(o.intCongruences, intCongruence{residue: 0, modulus: max(divisor, -divisor)})
+		}
+	}
+}
+
 // WithFloatMin sets the minimum value for random floats
 func WithFloatMin(min float64) OptionFunc {
 	return func(o *Option) {
@@ -201,11 +372,192 @@ func WithRomanMin(min int) OptionFunc {
 }
 
 // WithRomanMax sets the maximum value for random roman numerals
+// Values above the largest value of the notation (3999 for the standard notation) are clamped
 func WithRomanMax(max int) OptionFunc {
 	return func(o *Option) {
-		if max > 3999 {
-			max = 3999
-		}
 		o.romanMax = max
 	}
 }
+
+// WithRomanNotation sets the notation for random roman numerals (standard, additive, vinculum or apostrophus)
+func WithRomanNotation(notation string) OptionFunc {
+	return func(o *Option) {
+		switch notation {
+		case RomanNotationStandard, RomanNotationAdditive, RomanNotationVinculum, RomanNotationApostrophus:
+			o.romanNotation = notation
+		}
+	}
+}
+
+// WithRomanLowercase sets whether to generate lowercase roman numerals (e.g., xiv)
+func WithRomanLowercase(lowercase bool) OptionFunc {
+	return func(o *Option) {
+		o.romanLowercase = lowercase
+	}
+}
+
+// WithRomanUnicode sets whether to generate roman numerals with the Unicode Roman numeral characters (U+2160 block)
+func WithRomanUnicode(unicode bool) OptionFunc {
+	return func(o *Option) {
+		o.romanUnicode = unicode
+	}
+}
+
+// WithFormattedMin sets the minimum value for random formatted numbers
+func WithFormattedMin(min float64) OptionFunc {
+	return func(o *Option) {
+		o.formattedMin = min
+	}
+}
+
+// WithFormattedMax sets the maximum value for random formatted numbers
+func WithFormattedMax(max float64) OptionFunc {
+	return func(o *Option) {
+		o.formattedMax = max
+	}
+}
+
+// WithFormattedFractionDigits sets the number of fraction digits for random formatted numbers
+// For the scientific style it is the number of fraction digits of the mantissa
+func WithFormattedFractionDigits(digits int) OptionFunc {
+	return func(o *Option) {
+		if digits >= 0 {
+			o.formattedFractionDigits = digits
+		}
+	}
+}
+
+// WithFormattedStyle sets the style for random formatted numbers (decimal, percent or scientific)
+func WithFormattedStyle(style string) OptionFunc {
+	return func(o *Option) {
+		switch style {
+		case FormatStyleDecimal, FormatStylePercent, FormatStyleScientific:
+			o.formattedStyle = style
+		}
+	}
+}
+
+// WithFormattedGrouping sets whether to group the integer digits of random formatted numbers
+func WithFormattedGrouping(grouping bool) OptionFunc {
+	return func(o *Option) {
+		o.formattedGrouping = grouping
+	}
+}
+
+// WithFormattedNumberingSystem sets the digits used by random formatted numbers,
+// overriding the default numbering system of the locale
+func WithFormattedNumberingSystem(system string) OptionFunc {
+	return func(o *Option) {
+		if _, exists := numberingSystemDigits[system]; exists {
+			o.formattedNumberingSystem = system
+		}
+	}
+}
+
+// WithVectorMin sets the minimum value for uniform vector and matrix elements
+func WithVectorMin(min float64) OptionFunc {
+	return func(o *Option) {
+		o.vectorMin = min
+	}
+}
+
+// WithVectorMax sets the maximum value for uniform vector and matrix elements
+func WithVectorMax(max float64) OptionFunc {
+	return func(o *Option) {
+		o.vectorMax = max
+	}
+}
+
+// WithVectorMean sets the mean of Gaussian vector elements
+func WithVectorMean(mean float64) OptionFunc {
+	return func(o *Option) {
+		o.vectorMean = mean
+	}
+}
+
+// WithVectorStdDev sets the standard deviation of Gaussian vector elements
+func WithVectorStdDev(stdDev float64) OptionFunc {
+	return func(o *Option) {
+		if stdDev >= 0 {
+			o.vectorStdDev = stdDev
+		}
+	}
+}
+
+// WithProportionsTotal sets the total that random proportions sum to
+func WithProportionsTotal(total float64) OptionFunc {
+	return func(o *Option) {
+		o.proportionsTotal = total
+	}
+}
+
+// WithProportionsConcentration sets the Dirichlet concentration parameter of random proportions
+// A value of 1 spreads the proportions uniformly, larger values make them more even and smaller values more skewed
+func WithProportionsConcentration(alpha float64) OptionFunc {
+	return func(o *Option) {
+		if alpha > 0 {
+			o.proportionsConcentration = alpha
+		}
+	}
+}
+
+// WithProportionsFractionDigits rounds random proportions to the number of fraction digits,
+// while keeping their sum equal to the total
+func WithProportionsFractionDigits(digits int) OptionFunc {
+	return func(o *Option) {
+		if digits >= 0 {
+			o.proportionsFractionDigits = digits
+		}
+	}
+}
+
+// WithSequenceStart sets the first value of sequences
+func WithSequenceStart(start int) OptionFunc {
+	return func(o *Option) {
+		o.sequenceStart = start
+	}
+}
+
+// WithSequenceStep sets the increment between consecutive values of sequences
+func WithSequenceStep(step int) OptionFunc {
+	return func(o *Option) {
+		if step != 0 {
+			o.sequenceStep = step
+		}
+	}
+}
+
+// WithSnowflakeEpoch sets the epoch that Snowflake timestamps are measured from
+func WithSnowflakeEpoch(epoch time.Time) OptionFunc {
+	return func(o *Option) {
+		o.snowflakeEpoch = epoch
+	}
+}
+
+// WithSnowflakeWorker sets the worker (machine) ID embedded in Snowflake IDs
+// It is truncated to the configured number of worker bits
+func WithSnowflakeWorker(worker int64) OptionFunc {
+	return func(o *Option) {
+		if worker >= 0 {
+			o.snowflakeWorker = worker
+		}
+	}
+}
+
+// WithSnowflakeWorkerBits sets the number of bits used for the worker ID in Snowflake IDs (0-21)
+func WithSnowflakeWorkerBits(bits int) OptionFunc {
+	return func(o *Option) {
+		if bits >= 0 && bits <= 21 {
+			o.snowflakeWorkerBits = bits
+		}
+	}
+}
+
+// WithSnowflakeSequenceBits sets the number of bits used for the per-millisecond sequence in Snowflake IDs (1-22)
+func WithSnowflakeSequenceBits(bits int) OptionFunc {
+	return func(o *Option) {
+		if bits >= 1 && bits <= 22 {
+			o.snowflakeSequenceBits = bits
+		}
+	}
+}
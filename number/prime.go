@@ -0,0 +1,66 @@
+package number
+
+import "math/bits"
+
+// millerRabinBases are the witnesses that make the Miller-Rabin test deterministic for all 64-bit integers
+var millerRabinBases = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// isPrime reports whether n is a prime number using a deterministic Miller-Rabin test
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+
+	u := uint64(n)
+	for _, p := range millerRabinBases {
+		if u%p == 0 {
+			return u == p
+		}
+	}
+
+	// Write n-1 as d*2^s with d odd
+	d := u - 1
+	s := bits.TrailingZeros64(d)
+	d >>= s
+
+	for _, a := range millerRabinBases {
+		x := powMod(a, d, u)
+		if x == 1 || x == u-1 {
+			continue
+		}
+
+		composite := true
+		for r := 1; r < s; r++ {
+			x = mulMod(x, x, u)
+			if x == u-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mulMod computes a*b mod m without overflow
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// powMod computes base^exp mod m by repeated squaring
+func powMod(base, exp, m uint64) uint64 {
+	result := uint64(1)
+	base %= m
+	for exp > 0 {
+		if exp&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+		base = mulMod(base, base, m)
+		exp >>= 1
+	}
+	return result
+}
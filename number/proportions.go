@@ -0,0 +1,116 @@
+package number
+
+import (
+	"github.com/khchehab/muzayaf/random"
+	"math"
+	"sort"
+)
+
+// Proportions generates k random non-negative proportions that sum to a total (1 by default),
+// drawn from a symmetric Dirichlet distribution, which is useful to split budgets or percentages across categories
+// Without rounding, the last proportion absorbs the floating-point error so that the values sum to the total;
+// with WithProportionsFractionDigits, they are rounded with the largest remainder method so that the rounded
+// values sum to the total
+func Proportions(k int, opts ...OptionFunc) []float64 {
+	o := applyOptions(opts)
+
+	if k <= 0 {
+		return []float64{}
+	}
+
+	// Draw Gamma(alpha, 1) variables and normalize them
+	proportions := make([]float64, k)
+	var sum float64
+	for sum == 0 {
+		sum = 0
+		for i := range proportions {
+			proportions[i] = gammaFloat(o.proportionsConcentration)
+			sum += proportions[i]
+		}
+	}
+
+	for i := range proportions {
+		proportions[i] = proportions[i] / sum * o.proportionsTotal
+	}
+
+	if o.proportionsFractionDigits >= 0 {
+		return roundProportions(proportions, o.proportionsTotal, o.proportionsFractionDigits)
+	}
+
+	// Make the last proportion absorb the rounding error of the others
+	var partial float64
+	for _, p := range proportions[:k-1] {
+		partial += p
+	}
+	proportions[k-1] = o.proportionsTotal - partial
+
+	// A tiny last proportion can come out marginally negative, which is worse than an error of one ulp in the sum
+	if (o.proportionsTotal >= 0 && proportions[k-1] < 0) || (o.proportionsTotal < 0 && proportions[k-1] > 0) {
+		proportions[k-1] = 0
+	}
+
+	return proportions
+}
+
+// roundProportions rounds proportions to a number of fraction digits using the largest remainder method,
+// so that the rounded values add up to the rounded total
+func roundProportions(proportions []float64, total float64, digits int) []float64 {
+	multiplier := math.Pow10(digits)
+	units := int64(math.Round(math.Abs(total) * multiplier))
+	sign := 1.0
+	if total < 0 {
+		sign = -1
+	}
+
+	// Give each proportion the floor of its share, then hand out the remaining units by largest remainder
+	floors := make([]int64, len(proportions))
+	order := make([]int, len(proportions))
+	remainders := make([]float64, len(proportions))
+	var assigned int64
+	for i, p := range proportions {
+		scaled := math.Abs(p) * multiplier
+		floors[i] = int64(math.Floor(scaled))
+		remainders[i] = scaled - float64(floors[i])
+		assigned += floors[i]
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(a, b int) bool {
+		return remainders[order[a]] > remainders[order[b]]
+	})
+	for i := 0; assigned < units; i = (i + 1) % len(order) {
+		floors[order[i]]++
+		assigned++
+	}
+
+	rounded := make([]float64, len(proportions))
+	for i, f := range floors {
+		rounded[i] = sign * float64(f) / multiplier
+	}
+
+	return rounded
+}
+
+// gammaFloat draws a Gamma(alpha, 1) variable using the Marsaglia and Tsang method
+func gammaFloat(alpha float64) float64 {
+	if alpha < 1 {
+		// Boost the shape parameter and scale the result back down
+		return gammaFloat(alpha+1) * math.Pow(random.Float64(), 1/alpha)
+	}
+
+	d := alpha - 1.0/3
+	c := 1 / math.Sqrt(9*d)
+	for {
+		x := random.NormFloat64()
+		v := 1 + c*x
+		if v <= 0 {
+			continue
+		}
+		v = v * v * v
+
+		u := random.Float64()
+		if u < 1-0.0331*x*x*x*x || math.Log(u) < 0.5*x*x+d*(1-v+math.Log(v)) {
+			return d * v
+		}
+	}
+}
@@ -1,10 +1,16 @@
 package number
 
 import (
+	"errors"
+	"fmt"
 	"github.com/khchehab/muzayaf/random"
 	"strings"
+	"unicode"
 )
 
+// ErrInvalidRoman is returned when a string is not a valid Roman numeral
+var ErrInvalidRoman = errors.New("invalid roman numeral")
+
 // Roman numeral mapping
 var romanNumerals = []struct {
 	Value  int
@@ -25,16 +31,79 @@ var romanNumerals = []struct {
 	{1, "I"},
 }
 
+// Additive-only Roman numeral mapping, as used on clock faces (e.g., IIII)
+var additiveRomanNumerals = []struct {
+	Value  int
+	Symbol string
+}{
+	{1000, "M"},
+	{500, "D"},
+	{100, "C"},
+	{50, "L"},
+	{10, "X"},
+	{5, "V"},
+	{1, "I"},
+}
+
+// Apostrophus Roman numeral mapping for thousands, with the matching Unicode characters
+var apostrophusNumerals = []struct {
+	Value   int
+	Symbol  string
+	Unicode string
+}{
+	{100000, "CCCIↃↃↃ", "ↈ"},
+	{50000, "IↃↃↃ", "ↇ"},
+	{10000, "CCIↃↃ", "ↂ"},
+	{5000, "IↃↃ", "ↁ"},
+	{1000, "CIↃ", "ↀ"},
+}
+
+// romanLetterValues maps each Roman numeral letter to its value
+var romanLetterValues = map[rune]int{
+	'I': 1,
+	'V': 5,
+	'X': 10,
+	'L': 50,
+	'C': 100,
+	'D': 500,
+	'M': 1000,
+}
+
+// unicodeRomanLetters maps each Roman numeral letter to its uppercase character in the U+2160 block
+// The lowercase character is always 0x10 code points after the uppercase one
+var unicodeRomanLetters = map[rune]rune{
+	'I': 'Ⅰ',
+	'V': 'Ⅴ',
+	'X': 'Ⅹ',
+	'L': 'Ⅼ',
+	'C': 'Ⅽ',
+	'D': 'Ⅾ',
+	'M': 'Ⅿ',
+}
+
+const (
+	// romanVinculum is the combining overline that multiplies a letter by 1000
+	romanVinculum = '\u0305'
+	// romanReversedC is the reversed C used by the apostrophus notation
+	romanReversedC = 'Ↄ'
+	// romanSmallReversedC is the lowercase reversed C used by the apostrophus notation
+	romanSmallReversedC = 'ↄ'
+)
+
 // Roman generates a random Roman numeral as a string based on the provided options
 func Roman(opts ...OptionFunc) string {
 	o := applyOptions(opts)
 
-	// Validate options - Roman numerals are only valid from 1 to 3999
+	// Validate options - the maximum value depends on the notation
+	maxValue := romanMaxValue(o.romanNotation)
 	if o.romanMin < 1 {
 		o.romanMin = 1
 	}
-	if o.romanMax > 3999 {
-		o.romanMax = 3999
+	if o.romanMin > maxValue {
+		o.romanMin = maxValue
+	}
+	if o.romanMax > maxValue {
+		o.romanMax = maxValue
 	}
 	if o.romanMax < o.romanMin {
 		o.romanMin, o.romanMax = o.romanMax, o.romanMin
@@ -49,7 +118,178 @@ func Roman(opts ...OptionFunc) string {
 	}
 
 	// Convert to Roman numeral
-	return intToRoman(value)
+	return formatRoman(value, o.romanNotation, o.romanLowercase, o.romanUnicode)
+}
+
+// ParseRoman parses a Roman numeral and returns its integer value
+// It accepts exactly the forms produced by Roman in any notation, in uppercase or lowercase,
+// with ASCII letters or the Unicode Roman numeral characters (U+2160 block)
+// Any other input, such as "IIX", "IC" or mixed-case numerals, returns an error wrapping ErrInvalidRoman
+func ParseRoman(s string) (int, error) {
+	value, err := romanValue(s)
+	if err != nil {
+		return 0, err
+	}
+
+	// Strict validation: the input must be the canonical form of its value in one of the notations
+	for _, notation := range []string{RomanNotationStandard, RomanNotationAdditive, RomanNotationVinculum, RomanNotationApostrophus} {
+		if value > romanMaxValue(notation) {
+			continue
+		}
+
+		for _, lowercase := range []bool{false, true} {
+			for _, unicodeForm := range []bool{false, true} {
+				if formatRoman(value, notation, lowercase, unicodeForm) == s {
+					return value, nil
+				}
+			}
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %q is not in canonical form", ErrInvalidRoman, s)
+}
+
+// romanMaxValue returns the largest value that can be written in a Roman numeral notation
+func romanMaxValue(notation string) int {
+	switch notation {
+	case RomanNotationVinculum:
+		return 3999999
+	case RomanNotationApostrophus:
+		return 399999
+	default:
+		return 3999
+	}
+}
+
+// romanValue computes the value of a Roman numeral without checking that it is in canonical form
+func romanValue(s string) (int, error) {
+	if s == "" {
+		return 0, fmt.Errorf("%w: empty string", ErrInvalidRoman)
+	}
+
+	// Normalize the input to uppercase ASCII letters, overlines and reversed Cs
+	var normalized strings.Builder
+	for _, r := range s {
+		switch {
+		case r == romanSmallReversedC:
+			normalized.WriteRune(romanReversedC)
+		case r >= 'ⅰ' && r <= 'ⅿ':
+			normalized.WriteRune(r - 0x10)
+		case r == romanVinculum || r == romanReversedC:
+			normalized.WriteRune(r)
+		default:
+			if symbol := unicodeApostrophusSymbol(r); symbol != "" {
+				normalized.WriteString(symbol)
+			} else {
+				normalized.WriteRune(unicode.ToUpper(r))
+			}
+		}
+	}
+
+	runes := []rune(normalized.String())
+	for i, r := range runes {
+		for letter, unicodeLetter := range unicodeRomanLetters {
+			if r == unicodeLetter {
+				runes[i] = letter
+			}
+		}
+	}
+
+	// Split the numeral into token values
+	var values []int
+	for i := 0; i < len(runes); {
+		matched := false
+		for _, numeral := range apostrophusNumerals {
+			symbol := []rune(numeral.Symbol)
+			if i+len(symbol) <= len(runes) && string(runes[i:i+len(symbol)]) == numeral.Symbol {
+				values = append(values, numeral.Value)
+				i += len(symbol)
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		letterValue, ok := romanLetterValues[runes[i]]
+		if !ok {
+			return 0, fmt.Errorf("%w: unexpected character %q in %q", ErrInvalidRoman, runes[i], s)
+		}
+		i++
+
+		if i < len(runes) && runes[i] == romanVinculum {
+			letterValue *= 1000
+			i++
+		}
+		values = append(values, letterValue)
+	}
+
+	// Sum the tokens, subtracting any token that precedes a larger one
+	value := 0
+	for i, v := range values {
+		if i+1 < len(values) && v < values[i+1] {
+			value -= v
+		} else {
+			value += v
+		}
+	}
+
+	if value < 1 {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidRoman, s)
+	}
+
+	return value, nil
+}
+
+// unicodeApostrophusSymbol returns the ASCII apostrophus form of a Unicode apostrophus character
+// It returns an empty string if the character is not an apostrophus character
+func unicodeApostrophusSymbol(r rune) string {
+	for _, numeral := range apostrophusNumerals {
+		if string(r) == numeral.Unicode {
+			return numeral.Symbol
+		}
+	}
+	return ""
+}
+
+// formatRoman converts an integer to a Roman numeral in the given notation
+func formatRoman(num int, notation string, lowercase, unicodeForm bool) string {
+	var result string
+	switch notation {
+	case RomanNotationAdditive:
+		result = intToAdditiveRoman(num)
+	case RomanNotationVinculum:
+		result = intToVinculumRoman(num)
+	case RomanNotationApostrophus:
+		result = intToApostrophusRoman(num, unicodeForm)
+	default:
+		result = intToRoman(num)
+	}
+
+	if !lowercase && !unicodeForm {
+		return result
+	}
+
+	var converted strings.Builder
+	for _, r := range result {
+		switch {
+		case unicodeForm && unicodeRomanLetters[r] != 0:
+			letter := unicodeRomanLetters[r]
+			if lowercase {
+				letter += 0x10
+			}
+			converted.WriteRune(letter)
+		case lowercase && r == romanReversedC:
+			converted.WriteRune(romanSmallReversedC)
+		case lowercase:
+			converted.WriteRune(unicode.ToLower(r))
+		default:
+			converted.WriteRune(r)
+		}
+	}
+
+	return converted.String()
 }
 
 // intToRoman converts an integer to a Roman numeral
@@ -69,3 +309,64 @@ func intToRoman(num int) string {
 
 	return result.String()
 }
+
+// intToAdditiveRoman converts an integer to an additive-only Roman numeral (e.g., 4 is IIII)
+func intToAdditiveRoman(num int) string {
+	if num < 1 || num > 3999 {
+		return ""
+	}
+
+	var result strings.Builder
+
+	for _, numeral := range additiveRomanNumerals {
+		for num >= numeral.Value {
+			result.WriteString(numeral.Symbol)
+			num -= numeral.Value
+		}
+	}
+
+	return result.String()
+}
+
+// intToVinculumRoman converts an integer to a Roman numeral using the vinculum notation
+// Values from 4000 write the thousands as an overlined numeral (e.g., 4000 is I̅V̅)
+func intToVinculumRoman(num int) string {
+	if num < 1 || num > 3999999 {
+		return ""
+	}
+	if num < 4000 {
+		return intToRoman(num)
+	}
+
+	var result strings.Builder
+	for _, r := range intToRoman(num / 1000) {
+		result.WriteRune(r)
+		result.WriteRune(romanVinculum)
+	}
+	result.WriteString(intToRoman(num % 1000))
+
+	return result.String()
+}
+
+// intToApostrophusRoman converts an integer to a Roman numeral using the apostrophus notation
+// The thousands are written additively with apostrophus symbols (e.g., 5000 is IↃↃ)
+func intToApostrophusRoman(num int, unicodeForm bool) string {
+	if num < 1 || num > 399999 {
+		return ""
+	}
+
+	var result strings.Builder
+	for _, numeral := range apostrophusNumerals {
+		for num >= numeral.Value {
+			if unicodeForm {
+				result.WriteString(numeral.Unicode)
+			} else {
+				result.WriteString(numeral.Symbol)
+			}
+			num -= numeral.Value
+		}
+	}
+	result.WriteString(intToRoman(num))
+
+	return result.String()
+}
@@ -0,0 +1,52 @@
+package number
+
+import (
+	"slices"
+	"sync"
+)
+
+// Sequence generates monotonic auto-increment values, such as primary keys for relational data
+// It is safe for concurrent use
+type Sequence struct {
+	next int
+	step int
+	mu   sync.Mutex
+}
+
+// NewSequence creates an auto-increment sequence based on the provided options
+// By default it starts at 1 and increments by 1
+func NewSequence(opts ...OptionFunc) *Sequence {
+	o := applyOptions(opts)
+
+	return &Sequence{
+		next: o.sequenceStart,
+		step: o.sequenceStep,
+	}
+}
+
+// Next returns the next value of the sequence
+func (s *Sequence) Next() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	value := s.next
+	s.next += s.step
+	return value
+}
+
+// Take returns the next n values of the sequence
+func (s *Sequence) Take(n int) []int {
+	values := make([]int, 0, max(n, 0))
+	for i := 0; i < n; i++ {
+		values = append(values, s.Next())
+	}
+	return values
+}
+
+// SortedInts generates k distinct random integers based on the provided options, in ascending order
+// It accepts the same options as Ints
+func SortedInts(k int, opts ...OptionFunc) []int {
+	values := Ints(k, opts...)
+	slices.Sort(values)
+	return values
+}
@@ -0,0 +1,125 @@
+package number
+
+import (
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/khchehab/muzayaf/date"
+)
+
+// defaultSnowflakeEpoch is the epoch of Twitter Snowflake IDs (2010-11-04 01:42:54.657 UTC)
+var defaultSnowflakeEpoch = time.UnixMilli(1288834974657).UTC()
+
+// Snowflake generates timestamp-ordered 63-bit IDs in the Snowflake layout:
+// milliseconds since the epoch, followed by the worker ID and a per-millisecond sequence
+// IDs from a single generator are strictly increasing, and it is safe for concurrent use
+type Snowflake struct {
+	epoch        time.Time
+	worker       int64
+	workerBits   int
+	sequenceBits int
+
+	lastTimestamp int64
+	sequence      int64
+	mu            sync.Mutex
+}
+
+// NewSnowflake creates a Snowflake ID generator based on the provided options
+// By default it uses the Twitter epoch, worker 0, 10 worker bits and 12 sequence bits
+// The worker and sequence bits are limited to 22 in total, leaving at least 41 bits for the timestamp
+func NewSnowflake(opts ...OptionFunc) *Snowflake {
+	o := applyOptions(opts)
+
+	// Validate options - reduce the worker bits if the timestamp would be left with fewer than 41 bits
+	if o.snowflakeWorkerBits+o.snowflakeSequenceBits > 22 {
+		o.snowflakeWorkerBits = 22 - o.snowflakeSequenceBits
+	}
+
+	return &Snowflake{
+		epoch:         o.snowflakeEpoch,
+		worker:        o.snowflakeWorker & (1<<o.snowflakeWorkerBits - 1),
+		workerBits:    o.snowflakeWorkerBits,
+		sequenceBits:  o.snowflakeSequenceBits,
+		lastTimestamp: -1,
+	}
+}
+
+// Next returns the next ID using the current time
+func (s *Snowflake) Next() int64 {
+	return s.NextAt(time.Now())
+}
+
+// NextAt returns the next ID for an ID generated at the given time
+// Times before the previous ID's time are moved forward so that IDs stay ordered,
+// and when the sequence of a millisecond is exhausted the next millisecond is used
+// Timestamps are clamped to the last millisecond that fits in the timestamp bits (about 69 years after
+// the epoch with the default layout); once the sequence of that millisecond is exhausted, the largest ID is repeated
+func (s *Snowflake) NextAt(t time.Time) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	maxTimestamp := int64(1)<<(63-s.workerBits-s.sequenceBits) - 1
+	maxSequence := int64(1)<<s.sequenceBits - 1
+
+	// Sub saturates at about 292 years, which is past the timestamps of the default layout
+	timestamp := min(max(t.Sub(s.epoch).Milliseconds(), 0), maxTimestamp)
+
+	if timestamp <= s.lastTimestamp {
+		timestamp = s.lastTimestamp
+		switch {
+		case s.sequence < maxSequence:
+			s.sequence++
+		case timestamp < maxTimestamp:
+			timestamp++
+			s.sequence = 0
+		}
+	} else {
+		s.sequence = 0
+	}
+	s.lastTimestamp = timestamp
+
+	return timestamp<<(s.workerBits+s.sequenceBits) | s.worker<<s.sequenceBits | s.sequence
+}
+
+// Time returns the generation time embedded in an ID
+func (s *Snowflake) Time(id int64) time.Time {
+	// The timestamp can exceed a time.Duration with few worker and sequence bits, so it is added as Unix time
+	timestamp := id >> (s.workerBits + s.sequenceBits)
+	return time.Unix(s.epoch.Unix()+timestamp/1000, int64(s.epoch.Nanosecond())+timestamp%1000*int64(time.Millisecond)).In(s.epoch.Location())
+}
+
+// Worker returns the worker ID embedded in an ID
+func (s *Snowflake) Worker(id int64) int64 {
+	return id >> s.sequenceBits & (1<<s.workerBits - 1)
+}
+
+// Sequence returns the per-millisecond sequence embedded in an ID
+func (s *Snowflake) Sequence(id int64) int64 {
+	return id & (1<<s.sequenceBits - 1)
+}
+
+// SnowflakeIDs generates n ascending Snowflake IDs whose embedded generation times
+// are random dates between from and to, drawn with date.Between
+// It accepts the same options as NewSnowflake
+func SnowflakeIDs(n int, from, to time.Time, opts ...OptionFunc) []int64 {
+	if n <= 0 {
+		return []int64{}
+	}
+
+	times := make([]time.Time, n)
+	for i := range times {
+		times[i] = date.Between(from, to)
+	}
+	slices.SortFunc(times, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	generator := NewSnowflake(opts...)
+	ids := make([]int64, n)
+	for i, t := range times {
+		ids[i] = generator.NextAt(t)
+	}
+
+	return ids
+}
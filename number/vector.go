@@ -0,0 +1,127 @@
+package number
+
+import (
+	"github.com/khchehab/muzayaf/random"
+	"math"
+)
+
+// Vector generates a vector of n floats drawn uniformly from [min, max) based on the provided options
+func Vector(n int, opts ...OptionFunc) []float64 {
+	o := applyOptions(opts)
+
+	// Validate options
+	if o.vectorMin > o.vectorMax {
+		o.vectorMin, o.vectorMax = o.vectorMax, o.vectorMin
+	}
+
+	return uniformVector(n, o)
+}
+
+// GaussianVector generates a vector of n normally distributed floats based on the provided options
+// The mean and standard deviation default to 0 and 1
+func GaussianVector(n int, opts ...OptionFunc) []float64 {
+	o := applyOptions(opts)
+
+	vector := make([]float64, max(n, 0))
+	for i := range vector {
+		vector[i] = o.vectorMean + random.NormFloat64()*o.vectorStdDev
+	}
+
+	return vector
+}
+
+// UnitVector generates a random vector of n floats with a Euclidean length of 1,
+// uniformly distributed over the unit sphere, which is useful for fake embeddings
+func UnitVector(n int) []float64 {
+	vector := make([]float64, max(n, 0))
+	if n <= 0 {
+		return vector
+	}
+
+	// Normalize a standard Gaussian vector, retrying in the unlikely case that its length is zero
+	for {
+		var sumSquares float64
+		for i := range vector {
+			vector[i] = random.NormFloat64()
+			sumSquares += vector[i] * vector[i]
+		}
+
+		if sumSquares > 0 {
+			length := math.Sqrt(sumSquares)
+			for i := range vector {
+				vector[i] /= length
+			}
+			return vector
+		}
+	}
+}
+
+// Matrix generates a rows x cols matrix of floats drawn uniformly from [min, max) based on the provided options
+// It accepts the same options as Vector
+func Matrix(rows, cols int, opts ...OptionFunc) [][]float64 {
+	o := applyOptions(opts)
+
+	// Validate options
+	if o.vectorMin > o.vectorMax {
+		o.vectorMin, o.vectorMax = o.vectorMax, o.vectorMin
+	}
+
+	matrix := make([][]float64, max(rows, 0))
+	for i := range matrix {
+		matrix[i] = uniformVector(cols, o)
+	}
+
+	return matrix
+}
+
+// SymmetricMatrix generates a symmetric n x n matrix of floats drawn uniformly from [min, max)
+// It accepts the same options as Vector
+func SymmetricMatrix(n int, opts ...OptionFunc) [][]float64 {
+	matrix := Matrix(n, n, opts...)
+
+	// Mirror the upper triangle into the lower triangle
+	for i := range matrix {
+		for j := 0; j < i; j++ {
+			matrix[i][j] = matrix[j][i]
+		}
+	}
+
+	return matrix
+}
+
+// PositiveDefiniteMatrix generates a symmetric positive-definite n x n matrix, such as a covariance matrix
+// It is computed as B*Bᵀ + I, where B is a uniform random matrix built with the same options as Vector,
+// so all of its eigenvalues are at least 1
+func PositiveDefiniteMatrix(n int, opts ...OptionFunc) [][]float64 {
+	b := Matrix(n, n, opts...)
+
+	matrix := make([][]float64, len(b))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(b))
+	}
+
+	for i := range matrix {
+		for j := 0; j <= i; j++ {
+			var dot float64
+			for k := range b[i] {
+				dot += b[i][k] * b[j][k]
+			}
+			if i == j {
+				dot++
+			}
+			matrix[i][j] = dot
+			matrix[j][i] = dot
+		}
+	}
+
+	return matrix
+}
+
+// uniformVector generates a vector of n floats drawn uniformly from the validated vector range
+func uniformVector(n int, o Option) []float64 {
+	vector := make([]float64, max(n, 0))
+	for i := range vector {
+		vector[i] = o.vectorMin + random.Float64()*(o.vectorMax-o.vectorMin)
+	}
+	return vector
+}
@@ -67,3 +67,24 @@ func Int64N(n int64) int64 {
 	defer mu.RUnlock()
 	return currentRandom.Int64N(n)
 }
+
+// Uint64 generates a random uint64
+func Uint64() uint64 {
+	mu.RLock()
+	defer mu.RUnlock()
+	return currentRandom.Uint64()
+}
+
+// Uint64N generates a random uint64 in [0,n)
+func Uint64N(n uint64) uint64 {
+	mu.RLock()
+	defer mu.RUnlock()
+	return currentRandom.Uint64N(n)
+}
+
+// NormFloat64 generates a normally distributed float64 with mean 0 and standard deviation 1
+func NormFloat64() float64 {
+	mu.RLock()
+	defer mu.RUnlock()
+	return currentRandom.NormFloat64()
+}
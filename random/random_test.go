@@ -179,6 +179,80 @@ func TestInt64N(t *testing.T) {
 	}
 }
 
+// TestUint64 tests the Uint64 function
+func TestUint64(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test multiple calls to ensure reproducibility
+	val1 := Uint64()
+	val2 := Uint64()
+
+	// With a fixed seed, we should get consistent results
+	expectedVal1 := uint64(13820692522238390685)
+	expectedVal2 := uint64(4463228054989269439)
+
+	if val1 != expectedVal1 {
+		t.Errorf("Uint64() = %v, want %v", val1, expectedVal1)
+	}
+
+	if val2 != expectedVal2 {
+		t.Errorf("Uint64() second call = %v, want %v", val2, expectedVal2)
+	}
+}
+
+// TestUint64N tests the Uint64N function
+func TestUint64N(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test multiple calls to ensure reproducibility
+	val1 := Uint64N(100)
+	val2 := Uint64N(100)
+
+	// With a fixed seed, we should get consistent results
+	expectedVal1 := uint64(74)
+	expectedVal2 := uint64(24)
+
+	if val1 != expectedVal1 {
+		t.Errorf("Uint64N(100) = %v, want %v", val1, expectedVal1)
+	}
+
+	if val2 != expectedVal2 {
+		t.Errorf("Uint64N(100) second call = %v, want %v", val2, expectedVal2)
+	}
+
+	// Test with different bounds
+	val3 := Uint64N(1000)
+	expectedVal3 := uint64(741)
+
+	if val3 != expectedVal3 {
+		t.Errorf("Uint64N(1000) = %v, want %v", val3, expectedVal3)
+	}
+}
+
+// TestNormFloat64 tests the NormFloat64 function
+func TestNormFloat64(t *testing.T) {
+	setupTest(t)
+	defer teardownTest(t)
+
+	// Test multiple calls to ensure reproducibility
+	val1 := NormFloat64()
+	val2 := NormFloat64()
+
+	// With a fixed seed, we should get consistent results
+	expectedVal1 := -0.6570890145999749
+	expectedVal2 := -0.5806540886906191
+
+	if val1 != expectedVal1 {
+		t.Errorf("NormFloat64() = %v, want %v", val1, expectedVal1)
+	}
+
+	if val2 != expectedVal2 {
+		t.Errorf("NormFloat64() second call = %v, want %v", val2, expectedVal2)
+	}
+}
+
 // BenchmarkIntN benchmarks the IntN function
 func BenchmarkIntN(b *testing.B) {
 	for i := 0; i < b.N; i++ {
@@ -199,3 +273,24 @@ func BenchmarkInt64N(b *testing.B) {
 		Int64N(100)
 	}
 }
+
+// BenchmarkUint64 benchmarks the Uint64 function
+func BenchmarkUint64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Uint64()
+	}
+}
+
+// BenchmarkUint64N benchmarks the Uint64N function
+func BenchmarkUint64N(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Uint64N(100)
+	}
+}
+
+// BenchmarkNormFloat64 benchmarks the NormFloat64 function
+func BenchmarkNormFloat64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NormFloat64()
+	}
+}